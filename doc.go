@@ -4,6 +4,7 @@
 // The package includes functions for:
 // - Function composition and currying
 // - Slice operations (map, filter, reduce, etc.)
+// - Slice construction (range, repeat, times, etc.)
 // - Predicate functions for type checking and comparison
 // - Value manipulation and default value handling
 // - String conversion utilities
@@ -0,0 +1,287 @@
+package rchan
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Every operator in this package takes a context and starts goroutines that
+// exit as soon as either the input channels are closed or the context is done,
+// whichever comes first, closing their output channels on the way out. A
+// pipeline therefore never leaks goroutines as long as its context is
+// eventually cancelled, even if nobody drains the outputs. Values in flight
+// when the context is cancelled are dropped.
+
+// OrDone returns a channel that relays values from in until in is closed or
+// the context is done. It lets a consumer range over a channel without having
+// to select on the context itself.
+//
+// Example:
+//
+//	for v := range OrDone(ctx, events) {
+//		handle(v)
+//	}
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Map returns a channel of the results of applying a function to each value
+// received from in.
+//
+// Example:
+//
+//	lengths := Map(ctx, func(s string) int { return len(s) }, words)
+func Map[T, R any](ctx context.Context, fn func(T) R, in <-chan T) <-chan R {
+	out := make(chan R)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, fn(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Filter returns a channel of the values received from in that satisfy the
+// predicate function.
+//
+// Example:
+//
+//	errorsOnly := Filter(ctx, func(e Event) bool { return e.Level == "error" }, events)
+func Filter[T any](ctx context.Context, fn func(T) bool, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			if fn(v) && !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Batch returns a channel of slices grouping the values received from in.
+// A batch is sent when it reaches size values, or, if maxWait is positive,
+// when maxWait has passed since its first value arrived, whichever comes
+// first. The final partial batch is sent when in is closed. A size less than
+// or equal to zero is treated as 1. Each batch is a newly allocated slice.
+//
+// Example:
+//
+//	for rows := range Batch(ctx, 500, time.Second, records) {
+//		insert(rows)
+//	}
+func Batch[T any](ctx context.Context, size int, maxWait time.Duration, in <-chan T) <-chan []T {
+	size = max(1, size)
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		batch := make([]T, 0, size)
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+		}
+		defer stopTimer()
+
+		flush := func() bool {
+			stopTimer()
+			if len(batch) == 0 {
+				return true
+			}
+			full := batch
+			batch = make([]T, 0, size)
+			return send(ctx, out, full)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// Merge returns a channel that receives every value from all the input
+// channels, in the order they arrive. It is closed once every input is closed.
+//
+// Example:
+//
+//	all := Merge(ctx, fromShardA, fromShardB, fromShardC)
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func() {
+			defer wg.Done()
+			for {
+				v, ok := recv(ctx, in)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanIn is an alias for Merge, named for its role in fan-out/fan-in pipelines.
+//
+// Example:
+//
+//	workers := FanOut(ctx, 4, jobs)
+//	results := FanIn(ctx, Map(ctx, work, workers[0]), Map(ctx, work, workers[1]))
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	return Merge(ctx, ins...)
+}
+
+// FanOut distributes the values received from in across n output channels.
+// Each value goes to exactly one output, whichever is ready to take it, so a
+// slow consumer does not hold up the others. A value less than or equal to
+// zero for n is treated as 1.
+//
+// Example:
+//
+//	for _, jobs := range FanOut(ctx, 4, queue) {
+//		go worker(jobs)
+//	}
+func FanOut[T any](ctx context.Context, n int, in <-chan T) []<-chan T {
+	n = max(1, n)
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for {
+				v, ok := recv(ctx, in)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Tee returns two channels that each receive every value from in. Each value
+// is delivered to both outputs before the next one is read, so the slower
+// consumer sets the pace.
+//
+// Example:
+//
+//	toDisk, toNetwork := Tee(ctx, events)
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+	out1, out2 := make(chan T), make(chan T)
+	go func() {
+		defer close(out1)
+		defer close(out2)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			// Disable each output once it has the value so the other can catch up
+			o1, o2 := out1, out2
+			for range 2 {
+				select {
+				case <-ctx.Done():
+					return
+				case o1 <- v:
+					o1 = nil
+				case o2 <- v:
+					o2 = nil
+				}
+			}
+		}
+	}()
+	return out1, out2
+}
+
+// Buffer returns a channel with room for size values that relays values from
+// in, letting a fast producer run ahead of a slow consumer.
+//
+// Example:
+//
+//	smoothed := Buffer(ctx, 100, bursts)
+func Buffer[T any](ctx context.Context, size int, in <-chan T) <-chan T {
+	out := make(chan T, max(0, size))
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// recv receives a value from in, reporting false if in is closed or the context is done.
+func recv[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	case v, ok := <-in:
+		return v, ok
+	}
+}
+
+// send sends a value on out, reporting false if the context is done first.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- v:
+		return true
+	}
+}
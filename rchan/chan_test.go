@@ -0,0 +1,256 @@
+package rchan
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyNoLeaks fails the test if any goroutine started by this package is
+// still running shortly after the test ends. It plays the role of goleak
+// without adding a dependency.
+func verifyNoLeaks(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for {
+			leaked := leakedGoroutines()
+			if len(leaked) == 0 {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Errorf("found %d leaked goroutines:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	})
+}
+
+// leakedGoroutines returns the stacks of goroutines created by this package,
+// other than the calling goroutine.
+func leakedGoroutines() []string {
+	buf := make([]byte, 1<<20)
+	stacks := strings.Split(string(buf[:runtime.Stack(buf, true)]), "\n\n")
+
+	var leaked []string
+	for _, stack := range stacks[1:] {
+		if strings.Contains(stack, "created by github.com/jkaveri/ramda/rchan.") &&
+			!strings.Contains(stack, "created by github.com/jkaveri/ramda/rchan.Test") {
+			leaked = append(leaked, stack)
+		}
+	}
+	return leaked
+}
+
+// source returns a closed channel holding the given values.
+func source[T any](values ...T) <-chan T {
+	ch := make(chan T, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+// endless returns a channel that yields 0, 1, 2, ... until the context is done.
+func endless(ctx context.Context) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- i:
+			}
+		}
+	}()
+	return ch
+}
+
+func drain[T any](ch <-chan T) []T {
+	result := []T{}
+	for v := range ch {
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestOrDone(t *testing.T) {
+	verifyNoLeaks(t)
+	ctx := context.Background()
+
+	assert.Equal(t, []int{1, 2, 3}, drain(OrDone(ctx, source(1, 2, 3))))
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := OrDone(ctx, endless(ctx))
+	assert.Equal(t, 0, <-out)
+	cancel()
+	drain(out)
+}
+
+func TestMap(t *testing.T) {
+	verifyNoLeaks(t)
+
+	doubled := Map(context.Background(), func(n int) int { return n * 2 }, source(1, 2, 3))
+	assert.Equal(t, []int{2, 4, 6}, drain(doubled))
+}
+
+func TestFilter(t *testing.T) {
+	verifyNoLeaks(t)
+
+	even := Filter(context.Background(), func(n int) bool { return n%2 == 0 }, source(1, 2, 3, 4))
+	assert.Equal(t, []int{2, 4}, drain(even))
+}
+
+func TestBatch(t *testing.T) {
+	verifyNoLeaks(t)
+	ctx := context.Background()
+
+	batches := Batch(ctx, 2, 0, source(1, 2, 3, 4, 5))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, drain(batches))
+
+	assert.Equal(t, [][]int{{1}, {2}}, drain(Batch(ctx, 0, 0, source(1, 2))))
+	assert.Equal(t, [][]int{}, drain(Batch(ctx, 2, 0, source[int]())))
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	verifyNoLeaks(t)
+
+	in := make(chan int)
+	batches := Batch(context.Background(), 10, 20*time.Millisecond, in)
+
+	in <- 1
+	in <- 2
+	select {
+	case batch := <-batches:
+		assert.Equal(t, []int{1, 2}, batch)
+	case <-time.After(time.Second):
+		t.Fatal("expected partial batch to be flushed after maxWait")
+	}
+
+	in <- 3
+	close(in)
+	assert.Equal(t, [][]int{{3}}, drain(batches))
+}
+
+func TestMerge(t *testing.T) {
+	verifyNoLeaks(t)
+	ctx := context.Background()
+
+	merged := Merge(ctx, source(1, 2), source(3), source[int]())
+	assert.ElementsMatch(t, []int{1, 2, 3}, drain(merged))
+
+	assert.Empty(t, drain(Merge[int](ctx)))
+	assert.ElementsMatch(t, []int{4, 5}, drain(FanIn(ctx, source(4), source(5))))
+}
+
+func TestFanOut(t *testing.T) {
+	verifyNoLeaks(t)
+
+	outs := FanOut(context.Background(), 3, source(1, 2, 3, 4, 5, 6))
+	require.Len(t, outs, 3)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var received []int
+	for _, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range out {
+				mu.Lock()
+				received = append(received, v)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, received)
+
+	assert.Len(t, FanOut(context.Background(), 0, source[int]()), 1)
+}
+
+func TestTee(t *testing.T) {
+	verifyNoLeaks(t)
+
+	a, b := Tee(context.Background(), source(1, 2, 3))
+
+	var gotA, gotB []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		gotA = drain(a)
+	}()
+	go func() {
+		defer wg.Done()
+		gotB = drain(b)
+	}()
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3}, gotA)
+	assert.Equal(t, []int{1, 2, 3}, gotB)
+}
+
+func TestBuffer(t *testing.T) {
+	verifyNoLeaks(t)
+
+	in := make(chan int)
+	buffered := Buffer(context.Background(), 3, in)
+
+	// The producer can run ahead of the consumer by the buffer size
+	for i := 1; i <= 4; i++ {
+		select {
+		case in <- i:
+		case <-time.After(time.Second):
+			t.Fatalf("send %d blocked despite buffer", i)
+		}
+	}
+	close(in)
+	assert.Equal(t, []int{1, 2, 3, 4}, drain(buffered))
+}
+
+func TestCancellationDoesNotLeak(t *testing.T) {
+	verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numbers := endless(ctx)
+
+	// Build a pipeline using every operator, read a little, then walk away
+	doubled := Map(ctx, func(n int) int { return n * 2 }, Buffer(ctx, 4, numbers))
+	workers := FanOut(ctx, 3, Filter(ctx, func(n int) bool { return n%4 == 0 }, doubled))
+	merged := Merge(ctx, workers...)
+	left, right := Tee(ctx, OrDone(ctx, merged))
+	batches := Batch(ctx, 2, time.Millisecond, left)
+
+	go func() {
+		for range right {
+		}
+	}()
+	batch := <-batches
+	assert.NotEmpty(t, batch)
+
+	// Nobody drains batches any more; cancellation alone must release everything
+	cancel()
+}
+
+func TestBlockedSendDoesNotLeak(t *testing.T) {
+	verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// Outputs are never read, so every operator ends up blocked on a send
+	Map(ctx, func(n int) int { return n }, source(1, 2))
+	Tee(ctx, source(1))
+	FanOut(ctx, 2, source(1, 2, 3))
+	Batch(ctx, 1, 0, source(1, 2))
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+}
@@ -0,0 +1,67 @@
+package riter
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jkaveri/ramda/rslice"
+)
+
+func benchmarkInts(n int) []int {
+	numbers := make([]int, n)
+	for i := range numbers {
+		numbers[i] = i
+	}
+	return numbers
+}
+
+func BenchmarkPipelineEager(b *testing.B) {
+	numbers := benchmarkInts(100000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		doubled := rslice.Map(func(n int) int { return n * 2 }, numbers)
+		filtered := rslice.Filter(func(n int) bool { return n%3 == 0 }, doubled)
+		shifted := rslice.Map(func(n int) int { return n + 1 }, filtered)
+		rslice.Reduce(func(acc, n int) int { return acc + n }, 0, shifted)
+	}
+}
+
+func BenchmarkPipelineLazy(b *testing.B) {
+	numbers := benchmarkInts(100000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		doubled := Map(func(n int) int { return n * 2 }, slices.Values(numbers))
+		filtered := Filter(func(n int) bool { return n%3 == 0 }, doubled)
+		shifted := Map(func(n int) int { return n + 1 }, filtered)
+		Reduce(func(acc, n int) int { return acc + n }, 0, shifted)
+	}
+}
+
+func BenchmarkTakeEager(b *testing.B) {
+	numbers := benchmarkInts(100000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		doubled := rslice.Map(func(n int) int { return n * 2 }, numbers)
+		rslice.Take(10, doubled)
+	}
+}
+
+func BenchmarkTakeLazy(b *testing.B) {
+	numbers := benchmarkInts(100000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Collect(Take(10, Map(func(n int) int { return n * 2 }, slices.Values(numbers))))
+	}
+}
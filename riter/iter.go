@@ -0,0 +1,277 @@
+package riter
+
+import "iter"
+
+// The functions in this package are lazy counterparts of the rslice operations,
+// working on iter.Seq and iter.Seq2 values. Nothing is computed until the
+// resulting sequence is ranged over, and chained stages fuse into a single pass:
+// each element flows through the whole pipeline before the next one is read,
+// with no intermediate slices. Use slices.Values or maps.All to obtain a
+// sequence from a slice or a map.
+
+// Map returns a sequence that applies a function to each element of seq.
+//
+// Example:
+//
+//	doubled := Map(func(n int) int { return n * 2 }, slices.Values([]int{1, 2, 3}))
+//	// Yields: 2, 4, 6
+func Map[T, R any](fn func(T) R, seq iter.Seq[T]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns a sequence that applies a function to each key-value pair of seq.
+//
+// Example:
+//
+//	upper := Map2(func(k string, v int) (string, int) { return strings.ToUpper(k), v }, maps.All(m))
+func Map2[K1, V1, K2, V2 any](fn func(K1, V1) (K2, V2), seq iter.Seq2[K1, V1]) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the elements of seq that satisfy the predicate function.
+//
+// Example:
+//
+//	even := Filter(func(n int) bool { return n%2 == 0 }, slices.Values([]int{1, 2, 3, 4}))
+//	// Yields: 2, 4
+func Filter[T any](fn func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 returns a sequence of the key-value pairs of seq that satisfy the predicate function.
+//
+// Example:
+//
+//	positive := Filter2(func(k string, v int) bool { return v > 0 }, maps.All(m))
+func Filter2[K, V any](fn func(K, V) bool, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if fn(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of the first n elements of seq. The source is not read
+// past the n-th element, so Take can bound infinite sequences.
+//
+// Example:
+//
+//	firstThree := Take(3, slices.Values([]int{1, 2, 3, 4, 5}))
+//	// Yields: 1, 2, 3
+func Take[T any](n int, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Take2 returns a sequence of the first n key-value pairs of seq.
+func Take2[K, V any](n int, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for k, v := range seq {
+			if !yield(k, v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Drop returns a sequence of the elements of seq after the first n.
+//
+// Example:
+//
+//	rest := Drop(2, slices.Values([]int{1, 2, 3, 4, 5}))
+//	// Yields: 3, 4, 5
+func Drop[T any](n int, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Drop2 returns a sequence of the key-value pairs of seq after the first n.
+func Drop2[K, V any](n int, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := 0
+		for k, v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Flatten returns a sequence of the elements of every slice yielded by seq, in order.
+//
+// Example:
+//
+//	flat := Flatten(slices.Values([][]int{{1, 2}, {3}}))
+//	// Yields: 1, 2, 3
+func Flatten[T any](seq iter.Seq[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for s := range seq {
+			for _, v := range s {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Zip returns a sequence of pairs taking one element from each sequence in turn,
+// stopping when either sequence is exhausted.
+//
+// Example:
+//
+//	pairs := Zip(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"}))
+//	// Yields: (1, "a"), (2, "b")
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of consecutive chunks of the given size. The last
+// chunk holds the remaining elements and may be shorter. Each chunk is a newly
+// allocated slice. A size less than or equal to zero yields nothing.
+//
+// Example:
+//
+//	batches := Chunk(2, slices.Values([]int{1, 2, 3, 4, 5}))
+//	// Yields: [1 2], [3 4], [5]
+func Chunk[T any](size int, seq iter.Seq[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		chunk := make([]T, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Reduce consumes a sequence, applying a function to each element to accumulate a result.
+//
+// Example:
+//
+//	sum := Reduce(func(acc, n int) int { return acc + n }, 0, slices.Values([]int{1, 2, 3}))
+//	// Result: 6
+func Reduce[T, R any](fn func(R, T) R, initial R, seq iter.Seq[T]) R {
+	result := initial
+	for v := range seq {
+		result = fn(result, v)
+	}
+	return result
+}
+
+// Reduce2 consumes a sequence of key-value pairs, applying a function to each
+// pair to accumulate a result.
+//
+// Example:
+//
+//	total := Reduce2(func(acc int, k string, v int) int { return acc + v }, 0, maps.All(m))
+func Reduce2[K, V, R any](fn func(R, K, V) R, initial R, seq iter.Seq2[K, V]) R {
+	result := initial
+	for k, v := range seq {
+		result = fn(result, k, v)
+	}
+	return result
+}
+
+// Collect consumes a sequence and returns its elements as a slice.
+//
+// Example:
+//
+//	numbers := Collect(Take(2, slices.Values([]int{1, 2, 3})))
+//	// Result: []int{1, 2}
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := []T{}
+	for v := range seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Collect2 consumes a sequence of key-value pairs and returns them as a map.
+// If a key repeats, the last value wins.
+//
+// Example:
+//
+//	m := Collect2(Zip(slices.Values([]string{"a", "b"}), slices.Values([]int{1, 2})))
+//	// Result: map[string]int{"a": 1, "b": 2}
+func Collect2[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+	for k, v := range seq {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package riter
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naturals yields 0, 1, 2, ... forever and records how many values were produced.
+func naturals(produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	doubled := Map(func(n int) int { return n * 2 }, slices.Values([]int{1, 2, 3}))
+	assert.Equal(t, []int{2, 4, 6}, Collect(doubled))
+
+	lengths := Map(func(s string) int { return len(s) }, slices.Values([]string{"go", "rust"}))
+	assert.Equal(t, []int{2, 4}, Collect(lengths))
+}
+
+func TestMap2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	upper := Map2(func(k string, v int) (string, int) { return strings.ToUpper(k), v * 10 }, maps.All(m))
+	assert.Equal(t, map[string]int{"A": 10, "B": 20}, Collect2(upper))
+}
+
+func TestFilter(t *testing.T) {
+	even := Filter(func(n int) bool { return n%2 == 0 }, slices.Values([]int{1, 2, 3, 4}))
+	assert.Equal(t, []int{2, 4}, Collect(even))
+}
+
+func TestFilter2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": -2, "c": 3}
+	positive := Filter2(func(k string, v int) bool { return v > 0 }, maps.All(m))
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, Collect2(positive))
+}
+
+func TestTake(t *testing.T) {
+	numbers := slices.Values([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, []int{1, 2, 3}, Collect(Take(3, numbers)))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, Collect(Take(10, numbers)))
+	assert.Equal(t, []int{}, Collect(Take(0, numbers)))
+
+	// Take stops reading an infinite source
+	produced := 0
+	assert.Equal(t, []int{0, 1, 2}, Collect(Take(3, naturals(&produced))))
+	assert.Equal(t, 3, produced)
+}
+
+func TestTake2(t *testing.T) {
+	pairs := Take2(2, slices.All([]string{"a", "b", "c"}))
+	var keys []int
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{0, 1}, keys)
+}
+
+func TestDrop(t *testing.T) {
+	numbers := slices.Values([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, []int{3, 4, 5}, Collect(Drop(2, numbers)))
+	assert.Equal(t, []int{}, Collect(Drop(10, numbers)))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, Collect(Drop(-1, numbers)))
+}
+
+func TestDrop2(t *testing.T) {
+	pairs := Drop2(1, slices.All([]string{"a", "b", "c"}))
+	assert.Equal(t, map[int]string{1: "b", 2: "c"}, Collect2(pairs))
+}
+
+func TestFlatten(t *testing.T) {
+	flat := Flatten(slices.Values([][]int{{1, 2}, {}, {3}}))
+	assert.Equal(t, []int{1, 2, 3}, Collect(flat))
+	assert.Equal(t, []int{1, 2}, Collect(Take(2, flat)))
+}
+
+func TestZip(t *testing.T) {
+	pairs := Zip(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"}))
+
+	var numbers []int
+	var letters []string
+	for n, l := range pairs {
+		numbers = append(numbers, n)
+		letters = append(letters, l)
+	}
+	assert.Equal(t, []int{1, 2}, numbers)
+	assert.Equal(t, []string{"a", "b"}, letters)
+
+	// Infinite sources are fine as long as the other side ends
+	produced := 0
+	indexed := Collect2(Zip(naturals(&produced), slices.Values([]string{"x", "y"})))
+	assert.Equal(t, map[int]string{0: "x", 1: "y"}, indexed)
+}
+
+func TestChunk(t *testing.T) {
+	numbers := slices.Values([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Collect(Chunk(2, numbers)))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Collect(Chunk(10, numbers)))
+	assert.Equal(t, [][]int{}, Collect(Chunk(0, numbers)))
+
+	produced := 0
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}}, Collect(Take(2, Chunk(2, naturals(&produced)))))
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce(func(acc, n int) int { return acc + n }, 0, slices.Values([]int{1, 2, 3}))
+	assert.Equal(t, 6, sum)
+	assert.Equal(t, 7, Reduce(func(acc, n int) int { return acc + n }, 7, slices.Values([]int{})))
+}
+
+func TestReduce2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	total := Reduce2(func(acc int, k string, v int) int { return acc + v }, 0, maps.All(m))
+	assert.Equal(t, 3, total)
+}
+
+func TestPipelineFusion(t *testing.T) {
+	var trace []string
+	produced := 0
+	source := Map(func(n int) int {
+		trace = append(trace, "map")
+		return n * 10
+	}, naturals(&produced))
+	filtered := Filter(func(n int) bool {
+		trace = append(trace, "filter")
+		return n%20 == 0
+	}, source)
+
+	assert.Equal(t, []int{0, 20}, Collect(Take(2, filtered)))
+	// Each element passes through every stage before the next is read
+	assert.Equal(t, []string{"map", "filter", "map", "filter", "map", "filter"}, trace)
+	assert.Equal(t, 3, produced)
+}
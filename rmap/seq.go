@@ -0,0 +1,123 @@
+package rmap
+
+import (
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/jkaveri/ramda/rslice"
+	"golang.org/x/exp/constraints"
+)
+
+// All returns a sequence of the key-value pairs of a map. Like ranging over the
+// map directly, the order is unspecified; use SortedEntries for a fixed order.
+//
+// Example:
+//
+//	for k, v := range All(map[string]int{"a": 1, "b": 2}) {
+//		fmt.Println(k, v)
+//	}
+func All[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return maps.All(m)
+}
+
+// KeysSeq returns a sequence of the keys of a map, in unspecified order.
+// Unlike Keys it does not build a slice.
+//
+// Example:
+//
+//	for k := range KeysSeq(map[string]int{"a": 1, "b": 2}) {
+//		fmt.Println(k)
+//	}
+func KeysSeq[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return maps.Keys(m)
+}
+
+// ValuesSeq returns a sequence of the values of a map, in unspecified order.
+// Unlike Values it does not build a slice.
+//
+// Example:
+//
+//	total := 0
+//	for v := range ValuesSeq(map[string]int{"a": 1, "b": 2}) {
+//		total += v
+//	}
+//	// Result: total = 3
+func ValuesSeq[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return maps.Values(m)
+}
+
+// SortedKeys returns a sequence of the keys of a map in ascending order.
+// The keys are sorted when iteration starts, so later changes to the map are
+// reflected if the sequence is ranged over again.
+//
+// Example:
+//
+//	keys := slices.Collect(SortedKeys(map[string]int{"b": 2, "a": 1, "c": 3}))
+//	// Result: []string{"a", "b", "c"}
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedKeysBy returns a sequence of the keys of a map ordered by a comparison
+// function, following the rslice.SortBy convention: fn(a, b) reports whether a
+// comes before b. Keys that fn treats as equal come out in ascending order, so
+// the order never depends on map iteration order.
+//
+// Example:
+//
+//	byLen := slices.Collect(SortedKeysBy(func(a, b string) bool { return len(a) < len(b) }, m))
+//	// Result for keys "bb", "c", "a": []string{"a", "c", "bb"}
+func SortedKeysBy[K constraints.Ordered, V any](fn func(K, K) bool, m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range rslice.SortInPlace(fn, slices.Sorted(maps.Keys(m))) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedEntries returns a sequence of the key-value pairs of a map in ascending
+// key order.
+//
+// Example:
+//
+//	for k, v := range SortedEntries(map[string]int{"b": 2, "a": 1}) {
+//		fmt.Println(k, v)
+//	}
+//	// Prints: a 1, b 2
+func SortedEntries[K constraints.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return entriesInOrder(SortedKeys(m), m)
+}
+
+// SortedEntriesBy returns a sequence of the key-value pairs of a map with keys
+// ordered by a comparison function, following the rslice.SortBy convention.
+// Ties are broken as in SortedKeysBy.
+//
+// Example:
+//
+//	for k, v := range SortedEntriesBy(func(a, b string) bool { return len(a) < len(b) }, m) {
+//		fmt.Println(k, v)
+//	}
+func SortedEntriesBy[K constraints.Ordered, V any](fn func(K, K) bool, m map[K]V) iter.Seq2[K, V] {
+	return entriesInOrder(SortedKeysBy(fn, m), m)
+}
+
+// entriesInOrder yields the entries of m for each key of keys that is still present.
+func entriesInOrder[K comparable, V any](keys iter.Seq[K], m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k := range keys {
+			v, exists := m[k]
+			if exists && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package rmap
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	original := map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.Equal(t, original, maps.Collect(All(original)))
+}
+
+func TestKeysSeq(t *testing.T) {
+	original := map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(KeysSeq(original)))
+}
+
+func TestValuesSeq(t *testing.T) {
+	original := map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(ValuesSeq(original)))
+}
+
+func TestSortedKeys(t *testing.T) {
+	original := map[string]int{"d": 4, "b": 2, "a": 1, "c": 3}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, slices.Collect(SortedKeys(original)))
+	assert.Empty(t, slices.Collect(SortedKeys(map[int]int{})))
+
+	// Early stop
+	var first []string
+	for k := range SortedKeys(original) {
+		first = append(first, k)
+		if len(first) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b"}, first)
+}
+
+func TestSortedKeysBy(t *testing.T) {
+	original := map[int]string{1: "a", 3: "c", 2: "b"}
+	desc := slices.Collect(SortedKeysBy(func(a, b int) bool { return a > b }, original))
+	assert.Equal(t, []int{3, 2, 1}, desc)
+
+	// Keys the comparison treats as equal come out in the same order every time
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+	for range 20 {
+		words := map[string]int{"cc": 0, "b": 0, "aa": 0, "c": 0, "bb": 0, "a": 0}
+		assert.Equal(t, []string{"a", "b", "c", "aa", "bb", "cc"}, slices.Collect(SortedKeysBy(byLen, words)))
+	}
+
+	// Numeric ties come out in numeric order
+	evenFirst := func(a, b int) bool { return a%2 == 0 && b%2 != 0 }
+	numbers := map[int]bool{10: true, 9: true, 2: true, 1: true, 100: true}
+	assert.Equal(t, []int{2, 10, 100, 1, 9}, slices.Collect(SortedKeysBy(evenFirst, numbers)))
+}
+
+func TestSortedEntries(t *testing.T) {
+	original := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	var keys []string
+	var values []int
+	for k, v := range SortedEntries(original) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestSortedEntriesBy(t *testing.T) {
+	original := map[string]int{"ccc": 3, "a": 1, "bb": 2}
+
+	var keys []string
+	for k := range SortedEntriesBy(func(a, b string) bool { return len(a) > len(b) }, original) {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"ccc", "bb", "a"}, keys)
+
+	// Ties are broken the same way as in SortedKeysBy, with non-strict comparisons too
+	byLen := func(a, b string) bool { return len(a) <= len(b) }
+	for range 20 {
+		words := map[string]int{"cc": 6, "b": 2, "aa": 4, "c": 3, "bb": 5, "a": 1}
+		var values []int
+		for _, v := range SortedEntriesBy(byLen, words) {
+			values = append(values, v)
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, values)
+	}
+}
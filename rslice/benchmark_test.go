@@ -0,0 +1,138 @@
+package rslice
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+type benchmarkRecord struct {
+	ID   string
+	Rank int
+}
+
+func benchmarkRecords(n int) []benchmarkRecord {
+	rng := rand.New(rand.NewPCG(1, 2))
+	records := make([]benchmarkRecord, n)
+	for i := range records {
+		records[i] = benchmarkRecord{ID: strconv.Itoa(i), Rank: rng.IntN(n)}
+	}
+	return records
+}
+
+// bubbleSortBy is the previous SortBy implementation, kept as a baseline.
+func bubbleSortBy[T any](fn func(T, T) bool, slice []T) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+
+	for i := 0; i < len(result)-1; i++ {
+		for j := 0; j < len(result)-i-1; j++ {
+			if !fn(result[j], result[j+1]) {
+				result[j], result[j+1] = result[j+1], result[j]
+			}
+		}
+	}
+	return result
+}
+
+func BenchmarkSortByBubble(b *testing.B) {
+	records := benchmarkRecords(2000)
+	less := func(a, b benchmarkRecord) bool { return a.Rank < b.Rank }
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		bubbleSortBy(less, records)
+	}
+}
+
+func BenchmarkSortBy(b *testing.B) {
+	records := benchmarkRecords(2000)
+	less := func(a, b benchmarkRecord) bool { return a.Rank < b.Rank }
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		SortBy(less, records)
+	}
+}
+
+func BenchmarkSortBy50k(b *testing.B) {
+	records := benchmarkRecords(50000)
+	less := func(a, b benchmarkRecord) bool { return a.Rank < b.Rank }
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		SortBy(less, records)
+	}
+}
+
+func BenchmarkSortByKey50k(b *testing.B) {
+	records := benchmarkRecords(50000)
+	key := func(r benchmarkRecord) int { return r.Rank }
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		SortByKey(key, records)
+	}
+}
+
+func benchmarkInts(n int) []int {
+	numbers := make([]int, n)
+	for i := range numbers {
+		numbers[i] = i
+	}
+	return numbers
+}
+
+func BenchmarkMap(b *testing.B) {
+	numbers := benchmarkInts(10000)
+	double := func(n int) int { return n * 2 }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Map(double, numbers)
+	}
+}
+
+func BenchmarkMapInPlace(b *testing.B) {
+	numbers := benchmarkInts(10000)
+	double := func(n int) int { return n * 2 }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		MapInPlace(double, numbers)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	numbers := benchmarkInts(10000)
+	even := func(n int) bool { return n%2 == 0 }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Filter(even, numbers)
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	source := benchmarkInts(10000)
+	numbers := make([]int, len(source))
+	even := func(n int) bool { return n%2 == 0 }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		copy(numbers, source)
+		FilterInPlace(even, numbers)
+	}
+}
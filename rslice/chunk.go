@@ -0,0 +1,145 @@
+package rslice
+
+// The functions in this file avoid copying by returning sub-slices of the input.
+// Every returned sub-slice is capped with a full slice expression, so appending
+// to one never overwrites its neighbours or the input, but writing to an element
+// in place is visible through the input slice and vice versa. Use Map or copy
+// the results if they must be independent.
+
+// Chunk splits a slice into consecutive chunks of the given size.
+// The last chunk holds the remaining elements and may be shorter.
+// A size less than or equal to zero returns an empty result.
+// The chunks alias the input slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	chunks := Chunk(2, numbers)
+//	// Result: [][]int{{1, 2}, {3, 4}, {5}}
+func Chunk[T any](size int, slice []T) [][]T {
+	if size <= 0 {
+		return [][]T{}
+	}
+
+	count := len(slice) / size
+	if len(slice)%size != 0 {
+		count++
+	}
+
+	result := make([][]T, 0, count)
+	for start := 0; start < len(slice); start += size {
+		end := start + min(size, len(slice)-start)
+		result = append(result, slice[start:end:end])
+	}
+	return result
+}
+
+// Window returns sliding windows of the given size, advancing by step elements
+// between windows. Only full windows are returned, so an input shorter than size
+// produces an empty result, as does a size or step less than or equal to zero.
+// The windows alias the input slice and overlap when step is smaller than size.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	windows := Window(3, 1, numbers)
+//	// Result: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+func Window[T any](size, step int, slice []T) [][]T {
+	if size <= 0 || step <= 0 || len(slice) < size {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0, (len(slice)-size)/step+1)
+	for start := 0; ; start += step {
+		end := start + size
+		result = append(result, slice[start:end:end])
+		// Compare before advancing so a large step cannot overflow start
+		if start > len(slice)-size-step {
+			break
+		}
+	}
+	return result
+}
+
+// Partition splits a slice into the elements that satisfy the predicate function
+// and the elements that don't, preserving order in both.
+// Both results are newly allocated.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	even, odd := Partition(func(n int) bool { return n%2 == 0 }, numbers)
+//	// Result: even = []int{2, 4}, odd = []int{1, 3, 5}
+func Partition[T any](fn func(T) bool, slice []T) ([]T, []T) {
+	matched := make([]T, 0, len(slice))
+	rest := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if fn(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// SplitAt splits a slice into the elements before index i and the elements from i onwards.
+// Like Take and Drop, an index less than or equal to zero puts everything in the
+// second part and an index past the end puts everything in the first.
+// Both parts alias the input slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	head, tail := SplitAt(2, numbers)
+//	// Result: head = []int{1, 2}, tail = []int{3, 4, 5}
+func SplitAt[T any](i int, slice []T) ([]T, []T) {
+	i = max(0, min(i, len(slice)))
+	return slice[:i:i], slice[i:len(slice):len(slice)]
+}
+
+// SplitWhen splits a slice at the first element that satisfies the predicate function.
+// The second part starts with that element; if no element matches it is empty.
+// Both parts alias the input slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 1}
+//	before, after := SplitWhen(func(n int) bool { return n > 2 }, numbers)
+//	// Result: before = []int{1, 2}, after = []int{3, 4, 1}
+func SplitWhen[T any](fn func(T) bool, slice []T) ([]T, []T) {
+	for i, v := range slice {
+		if fn(v) {
+			return slice[:i:i], slice[i:len(slice):len(slice)]
+		}
+	}
+	return slice[:len(slice):len(slice)], slice[len(slice):len(slice):len(slice)]
+}
+
+// ChunkBy groups consecutive elements that produce the same key.
+// Unlike GroupBy, elements with equal keys that are not adjacent end up in
+// separate chunks, and the order of the input is preserved.
+// The chunks alias the input slice.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 2, 4, 5}
+//	chunks := ChunkBy(func(n int) bool { return n%2 == 0 }, numbers)
+//	// Result: [][]int{{1, 3}, {2, 4}, {5}}
+func ChunkBy[T any, K comparable](fn func(T) K, slice []T) [][]T {
+	result := [][]T{}
+	if len(slice) == 0 {
+		return result
+	}
+
+	start := 0
+	key := fn(slice[0])
+	for i := 1; i < len(slice); i++ {
+		next := fn(slice[i])
+		if next != key {
+			result = append(result, slice[start:i:i])
+			start, key = i, next
+		}
+	}
+	return append(result, slice[start:len(slice):len(slice)])
+}
@@ -0,0 +1,116 @@
+package rslice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Chunk(2, numbers))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(10, numbers))
+	assert.Equal(t, [][]int{}, Chunk(0, numbers))
+	assert.Equal(t, [][]int{}, Chunk(-1, numbers))
+	assert.Equal(t, [][]int{}, Chunk(2, []int{}))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(math.MaxInt, numbers))
+
+	// Appending to a chunk must not overwrite the next one
+	chunks := Chunk(2, numbers)
+	_ = append(chunks[0], 99)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, numbers)
+	assert.Equal(t, []int{3, 4}, chunks[1])
+}
+
+func TestWindow(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, Window(3, 1, numbers))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, Window(2, 2, numbers))
+	assert.Equal(t, [][]int{{1}, {4}}, Window(1, 3, numbers))
+	assert.Equal(t, [][]int{}, Window(6, 1, numbers))
+	assert.Equal(t, [][]int{}, Window(0, 1, numbers))
+	assert.Equal(t, [][]int{}, Window(2, 0, numbers))
+	assert.Equal(t, [][]int{{1, 2}}, Window(2, math.MaxInt, numbers))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Window(5, math.MaxInt, numbers))
+}
+
+func TestPartition(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	even, odd := Partition(func(n int) bool { return n%2 == 0 }, numbers)
+	assert.Equal(t, []int{2, 4}, even)
+	assert.Equal(t, []int{1, 3, 5}, odd)
+
+	matched, rest := Partition(func(n int) bool { return true }, []int{})
+	assert.Empty(t, matched)
+	assert.Empty(t, rest)
+}
+
+func TestSplitAt(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	head, tail := SplitAt(2, numbers)
+	assert.Equal(t, []int{1, 2}, head)
+	assert.Equal(t, []int{3, 4, 5}, tail)
+
+	head, tail = SplitAt(0, numbers)
+	assert.Empty(t, head)
+	assert.Equal(t, numbers, tail)
+
+	head, tail = SplitAt(-3, numbers)
+	assert.Empty(t, head)
+	assert.Equal(t, numbers, tail)
+
+	head, tail = SplitAt(10, numbers)
+	assert.Equal(t, numbers, head)
+	assert.Empty(t, tail)
+
+	// Appending to head must not overwrite tail
+	head, tail = SplitAt(2, numbers)
+	_ = append(head, 99)
+	assert.Equal(t, []int{3, 4, 5}, tail)
+
+	// Appending to tail must not write into the input's spare capacity
+	spare := make([]int, 5, 10)
+	_, tail = SplitAt(2, spare)
+	assert.Equal(t, len(tail), cap(tail))
+}
+
+func TestSplitWhen(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 1}
+
+	before, after := SplitWhen(func(n int) bool { return n > 2 }, numbers)
+	assert.Equal(t, []int{1, 2}, before)
+	assert.Equal(t, []int{3, 4, 1}, after)
+
+	before, after = SplitWhen(func(n int) bool { return n > 10 }, numbers)
+	assert.Equal(t, numbers, before)
+	assert.Empty(t, after)
+
+	before, after = SplitWhen(func(n int) bool { return n == 1 }, numbers)
+	assert.Empty(t, before)
+	assert.Equal(t, numbers, after)
+
+	// Both parts are capped, whether or not an element matches
+	spare := make([]int, 5, 10)
+	for _, fn := range []func(int) bool{
+		func(n int) bool { return true },
+		func(n int) bool { return false },
+	} {
+		before, after = SplitWhen(fn, spare)
+		assert.Equal(t, len(before), cap(before))
+		assert.Equal(t, len(after), cap(after))
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	numbers := []int{1, 3, 2, 4, 5}
+	chunks := ChunkBy(func(n int) bool { return n%2 == 0 }, numbers)
+	assert.Equal(t, [][]int{{1, 3}, {2, 4}, {5}}, chunks)
+
+	words := []string{"apple", "avocado", "banana", "apricot"}
+	byLetter := ChunkBy(func(s string) byte { return s[0] }, words)
+	assert.Equal(t, [][]string{{"apple", "avocado"}, {"banana"}, {"apricot"}}, byLetter)
+
+	assert.Equal(t, [][]int{}, ChunkBy(func(n int) int { return n }, []int{}))
+}
@@ -0,0 +1,188 @@
+package rslice
+
+import "iter"
+
+// The generators in this file produce their results lazily, so spaces far
+// larger than memory can be iterated and iteration can stop early. Results come
+// in lexicographic order of the element indices, which makes them deterministic.
+// Every yielded slice is newly allocated and may be retained or modified.
+
+// Permutations returns a sequence of all orderings of the elements of a slice.
+// Elements are treated as distinct by position, so equal elements produce
+// repeated permutations. A slice of n elements yields n! permutations; an empty
+// slice yields a single empty permutation.
+//
+// Example:
+//
+//	for p := range Permutations([]string{"a", "b", "c"}) {
+//		fmt.Println(p)
+//	}
+//	// Prints: [a b c] [a c b] [b a c] [b c a] [c a b] [c b a]
+func Permutations[T any](slice []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := make([]int, len(slice))
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			if !yield(pick(indices, slice)) {
+				return
+			}
+
+			// Advance to the next permutation of indices in lexicographic order
+			i := len(indices) - 2
+			for i >= 0 && indices[i] >= indices[i+1] {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			j := len(indices) - 1
+			for indices[j] <= indices[i] {
+				j--
+			}
+			indices[i], indices[j] = indices[j], indices[i]
+			ReverseInPlace(indices[i+1:])
+		}
+	}
+}
+
+// Combinations returns a sequence of all ways to choose k elements from a slice,
+// keeping their original relative order. A k of zero yields a single empty
+// combination; a negative k or one larger than the slice yields nothing.
+//
+// Example:
+//
+//	for c := range Combinations(2, []int{1, 2, 3}) {
+//		fmt.Println(c)
+//	}
+//	// Prints: [1 2] [1 3] [2 3]
+func Combinations[T any](k int, slice []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(slice)
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			if !yield(pick(indices, slice)) {
+				return
+			}
+
+			// Find the rightmost index that can still move right
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CartesianProduct returns a sequence of every tuple that takes one element from
+// each slice, in order, with the last slice varying fastest. If any slice is
+// empty the sequence yields nothing; with no slices it yields one empty tuple.
+//
+// Example:
+//
+//	for p := range CartesianProduct([]string{"x", "y"}, []string{"1", "2"}) {
+//		fmt.Println(p)
+//	}
+//	// Prints: [x 1] [x 2] [y 1] [y 2]
+func CartesianProduct[T any](sets ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, s := range sets {
+			if len(s) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(sets))
+		for {
+			tuple := make([]T, len(sets))
+			for i, idx := range indices {
+				tuple[i] = sets[i][idx]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			// Increment the indices like an odometer
+			i := len(indices) - 1
+			for i >= 0 {
+				indices[i]++
+				if indices[i] < len(sets[i]) {
+					break
+				}
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// PowerSet returns a sequence of every subset of a slice, including the empty
+// set and the whole slice, with elements in their original relative order.
+// A slice of n elements yields 2^n subsets.
+//
+// Example:
+//
+//	for s := range PowerSet([]int{1, 2, 3}) {
+//		fmt.Println(s)
+//	}
+//	// Prints: [] [1] [1 2] [1 2 3] [1 3] [2] [2 3] [3]
+func PowerSet[T any](slice []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := make([]int, 0, len(slice))
+		if !yield([]T{}) {
+			return
+		}
+
+		for {
+			// Extend the current subset with the next index if there is one,
+			// otherwise drop the last index and advance the one before it
+			if len(indices) == 0 {
+				if len(slice) == 0 {
+					return
+				}
+				indices = append(indices, 0)
+			} else if last := indices[len(indices)-1]; last+1 < len(slice) {
+				indices = append(indices, last+1)
+			} else {
+				indices = indices[:len(indices)-1]
+				if len(indices) == 0 {
+					return
+				}
+				indices[len(indices)-1]++
+			}
+
+			if !yield(pick(indices, slice)) {
+				return
+			}
+		}
+	}
+}
+
+// pick returns a new slice holding the elements of slice at the given indices.
+func pick[T any](indices []int, slice []T) []T {
+	result := make([]T, len(indices))
+	for i, idx := range indices {
+		result[i] = slice[idx]
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package rslice
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermutations(t *testing.T) {
+	perms := slices.Collect(Permutations([]string{"a", "b", "c"}))
+	assert.Equal(t, [][]string{
+		{"a", "b", "c"},
+		{"a", "c", "b"},
+		{"b", "a", "c"},
+		{"b", "c", "a"},
+		{"c", "a", "b"},
+		{"c", "b", "a"},
+	}, perms)
+
+	// Equal elements are distinct by position
+	assert.Len(t, slices.Collect(Permutations([]int{1, 1, 2})), 6)
+	assert.Equal(t, [][]int{{}}, slices.Collect(Permutations([]int{})))
+
+	// Early stop
+	count := 0
+	for range Permutations([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	assert.Equal(t, 3, count)
+}
+
+func TestCombinations(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+		slices.Collect(Combinations(2, []int{1, 2, 3, 4})))
+	assert.Equal(t, [][]int{{1, 2, 3}}, slices.Collect(Combinations(3, []int{1, 2, 3})))
+	assert.Equal(t, [][]int{{}}, slices.Collect(Combinations(0, []int{1, 2})))
+	assert.Empty(t, slices.Collect(Combinations(3, []int{1, 2})))
+	assert.Empty(t, slices.Collect(Combinations(-1, []int{1, 2})))
+	assert.Len(t, slices.Collect(Combinations(3, []int{1, 2, 3, 4, 5, 6})), 20)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	product := slices.Collect(CartesianProduct([]string{"x", "y"}, []string{"1", "2"}, []string{"!"}))
+	assert.Equal(t, [][]string{
+		{"x", "1", "!"},
+		{"x", "2", "!"},
+		{"y", "1", "!"},
+		{"y", "2", "!"},
+	}, product)
+
+	assert.Empty(t, slices.Collect(CartesianProduct([]int{1, 2}, []int{})))
+	assert.Equal(t, [][]int{{}}, slices.Collect(CartesianProduct[int]()))
+	assert.Equal(t, [][]int{{1}, {2}}, slices.Collect(CartesianProduct([]int{1, 2})))
+}
+
+func TestPowerSet(t *testing.T) {
+	subsets := slices.Collect(PowerSet([]int{1, 2, 3}))
+	assert.Equal(t, [][]int{{}, {1}, {1, 2}, {1, 2, 3}, {1, 3}, {2}, {2, 3}, {3}}, subsets)
+
+	assert.Equal(t, [][]int{{}}, slices.Collect(PowerSet([]int{})))
+	assert.Len(t, slices.Collect(PowerSet([]int{1, 2, 3, 4, 5})), 32)
+
+	// Yielded slices are independent
+	for s := range PowerSet([]int{1, 2}) {
+		if len(s) > 0 {
+			s[0] = 99
+		}
+	}
+	assert.Equal(t, [][]int{{}, {1}, {1, 2}, {2}}, slices.Collect(PowerSet([]int{1, 2})))
+}
@@ -0,0 +1,160 @@
+package rslice
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HistogramBucket is one bucket of a histogram. It counts the values v with
+// Min <= v < Max; the last bucket of a histogram also includes Max.
+type HistogramBucket struct {
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// CountBy counts the elements of a slice by a key function. Unlike GroupBy it
+// does not build the groups themselves.
+//
+// Example:
+//
+//	words := []string{"apple", "avocado", "banana"}
+//	counts := CountBy(func(s string) byte { return s[0] }, words)
+//	// Result: map[byte]int{'a': 2, 'b': 1}
+func CountBy[T any, K comparable](fn func(T) K, slice []T) map[K]int {
+	result := make(map[K]int)
+	for _, v := range slice {
+		result[fn(v)]++
+	}
+	return result
+}
+
+// CountBySorted counts the elements of a slice by a key function and returns the
+// counts as key/count pairs sorted by key.
+//
+// Example:
+//
+//	words := []string{"banana", "apple", "avocado"}
+//	counts := CountBySorted(func(s string) string { return s[:1] }, words)
+//	// Result: []struct{Key string; Count int}{{"a", 2}, {"b", 1}}
+func CountBySorted[T any, K constraints.Ordered](fn func(T) K, slice []T) []struct {
+	Key   K
+	Count int
+} {
+	counts := CountBy(fn, slice)
+	result := make([]struct {
+		Key   K
+		Count int
+	}, 0, len(counts))
+	for k, c := range counts {
+		result = append(result, struct {
+			Key   K
+			Count int
+		}{k, c})
+	}
+	slices.SortFunc(result, func(a, b struct {
+		Key   K
+		Count int
+	}) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return result
+}
+
+// Frequencies counts how many times each element appears in a slice.
+//
+// Example:
+//
+//	words := []string{"a", "b", "a"}
+//	freq := Frequencies(words)
+//	// Result: map[string]int{"a": 2, "b": 1}
+func Frequencies[T comparable](slice []T) map[T]int {
+	return CountBy(func(v T) T { return v }, slice)
+}
+
+// FrequenciesSorted counts how many times each element appears in a slice and
+// returns the counts as value/count pairs sorted by value.
+//
+// Example:
+//
+//	numbers := []int{3, 1, 3, 2, 3}
+//	freq := FrequenciesSorted(numbers)
+//	// Result: []struct{Key int; Count int}{{1, 1}, {2, 1}, {3, 3}}
+func FrequenciesSorted[T constraints.Ordered](slice []T) []struct {
+	Key   T
+	Count int
+} {
+	return CountBySorted(func(v T) T { return v }, slice)
+}
+
+// Histogram counts numeric values into the given number of equal-width buckets
+// spanning the smallest to the largest value. Buckets are returned in ascending
+// order. NaN and infinite values are left out, since they have no place in an
+// equal-width bucket. If the slice has no finite values or bins is less than or
+// equal to zero, the result is empty; if every value is the same, all of them
+// land in the first bucket.
+//
+// Example:
+//
+//	values := []int{1, 2, 2, 3, 4, 5}
+//	buckets := Histogram(2, values)
+//	// Result: []HistogramBucket{{Min: 1, Max: 3, Count: 3}, {Min: 3, Max: 5, Count: 3}}
+func Histogram[T Number](bins int, slice []T) []HistogramBucket {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, v := range slice {
+		if f := float64(v); !isNonFinite(f) {
+			low, high = min(low, f), max(high, f)
+		}
+	}
+	if bins <= 0 || low > high {
+		return []HistogramBucket{}
+	}
+
+	width := (high - low) / float64(bins)
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = low + width*float64(i)
+	}
+	// Avoid rounding leaving the maximum value outside the last bucket
+	edges[bins] = high
+
+	return HistogramEdges(edges, slice)
+}
+
+// HistogramEdges counts numeric values into buckets defined by ascending edges,
+// so n edges produce n-1 buckets returned in ascending order. Values outside the
+// first and last edge and NaN values are not counted. Fewer than two edges produce an empty result.
+//
+// Example:
+//
+//	latencies := []float64{12, 48, 95, 180, 450}
+//	buckets := HistogramEdges([]float64{0, 50, 100, 500}, latencies)
+//	// Result: []HistogramBucket{{0, 50, 2}, {50, 100, 1}, {100, 500, 2}}
+func HistogramEdges[T Number](edges []float64, slice []T) []HistogramBucket {
+	if len(edges) < 2 {
+		return []HistogramBucket{}
+	}
+
+	result := make([]HistogramBucket, len(edges)-1)
+	for i := range result {
+		result[i] = HistogramBucket{Min: edges[i], Max: edges[i+1]}
+	}
+
+	last := len(edges) - 1
+	for _, v := range slice {
+		f := float64(v)
+		if math.IsNaN(f) || f < edges[0] || f > edges[last] {
+			continue
+		}
+		// Index of the first edge greater than f, minus one, is the bucket
+		i, _ := slices.BinarySearch(edges, f)
+		if i < len(edges) && edges[i] == f {
+			i++
+		}
+		result[min(i-1, last-1)].Count++
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package rslice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountBy(t *testing.T) {
+	words := []string{"apple", "avocado", "banana"}
+	counts := CountBy(func(s string) byte { return s[0] }, words)
+	assert.Equal(t, map[byte]int{'a': 2, 'b': 1}, counts)
+
+	assert.Empty(t, CountBy(func(s string) int { return len(s) }, []string{}))
+}
+
+func TestCountBySorted(t *testing.T) {
+	words := []string{"cherry", "banana", "apple", "avocado", "blueberry"}
+	counts := CountBySorted(func(s string) string { return s[:1] }, words)
+
+	assert.Len(t, counts, 3)
+	keys := Map(func(c struct {
+		Key   string
+		Count int
+	}) string {
+		return c.Key
+	}, counts)
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, 2, counts[0].Count)
+	assert.Equal(t, 2, counts[1].Count)
+	assert.Equal(t, 1, counts[2].Count)
+}
+
+func TestFrequencies(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, Frequencies([]string{"a", "b", "a"}))
+	assert.Empty(t, Frequencies([]int{}))
+}
+
+func TestFrequenciesSorted(t *testing.T) {
+	freq := FrequenciesSorted([]int{3, 1, 3, 2, 3})
+
+	assert.Len(t, freq, 3)
+	for i, expected := range []struct{ Key, Count int }{{1, 1}, {2, 1}, {3, 3}} {
+		assert.Equal(t, expected.Key, freq[i].Key)
+		assert.Equal(t, expected.Count, freq[i].Count)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	buckets := Histogram(2, []int{1, 2, 2, 3, 4, 5})
+	assert.Equal(t, []HistogramBucket{
+		{Min: 1, Max: 3, Count: 3},
+		{Min: 3, Max: 5, Count: 3},
+	}, buckets)
+
+	// Maximum value lands in the last bucket
+	buckets = Histogram(3, []float64{0, 0.1, 0.2, 0.3})
+	assert.Len(t, buckets, 3)
+	assert.Equal(t, 4, Sum(Map(func(b HistogramBucket) int { return b.Count }, buckets)))
+	assert.Equal(t, 2, buckets[2].Count)
+
+	// All values equal
+	buckets = Histogram(2, []int{7, 7, 7})
+	assert.Equal(t, 3, buckets[0].Count)
+	assert.Equal(t, 0, buckets[1].Count)
+
+	// NaN values are skipped, wherever they appear
+	nan := math.NaN()
+	buckets = Histogram(2, []float64{nan, 1, 2, nan, 3})
+	assert.Equal(t, []HistogramBucket{
+		{Min: 1, Max: 2, Count: 1},
+		{Min: 2, Max: 3, Count: 2},
+	}, buckets)
+
+	assert.Empty(t, Histogram(3, []int{}))
+	assert.Empty(t, Histogram(3, []float64{nan, nan}))
+
+	// Infinite values are left out rather than stretching the buckets
+	buckets = Histogram(2, []float64{1, 2, math.Inf(1), 3, math.Inf(-1)})
+	assert.Equal(t, []HistogramBucket{
+		{Min: 1, Max: 2, Count: 1},
+		{Min: 2, Max: 3, Count: 2},
+	}, buckets)
+	assert.Empty(t, Histogram(3, []float64{math.Inf(1), math.Inf(-1)}))
+	assert.Empty(t, Histogram(0, []int{1, 2}))
+}
+
+func TestHistogramEdges(t *testing.T) {
+	latencies := []float64{12, 48, 50, 95, 180, 500, 650, -1}
+	buckets := HistogramEdges([]float64{0, 50, 100, 500}, latencies)
+
+	assert.Equal(t, []HistogramBucket{
+		{Min: 0, Max: 50, Count: 2},
+		{Min: 50, Max: 100, Count: 2},
+		{Min: 100, Max: 500, Count: 2},
+	}, buckets)
+
+	assert.Empty(t, HistogramEdges([]float64{1}, latencies))
+
+	// NaN values are not counted
+	buckets = HistogramEdges([]float64{0, 50, 100}, []float64{math.NaN(), 10, 60})
+	assert.Equal(t, 1, buckets[0].Count)
+	assert.Equal(t, 1, buckets[1].Count)
+}
@@ -0,0 +1,278 @@
+package rslice
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditKind is the kind of operation in an edit script produced by Diff.
+type EditKind int
+
+const (
+	// EditEqual keeps an element that is present in both slices.
+	EditEqual EditKind = iota
+	// EditInsert adds an element that is only present in the second slice.
+	EditInsert
+	// EditDelete removes an element that is only present in the first slice.
+	EditDelete
+)
+
+// String returns the name of the edit kind.
+func (k EditKind) String() string {
+	switch k {
+	case EditEqual:
+		return "equal"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("EditKind(%d)", int(k))
+	}
+}
+
+// Edit is one operation of an edit script. AIndex is the position of the element
+// in the first slice and BIndex its position in the second; the index for the
+// side the element is missing from is -1.
+type Edit[T any] struct {
+	Kind   EditKind
+	AIndex int
+	BIndex int
+	Value  T
+}
+
+// Diff computes the shortest edit script that turns slice a into slice b, using
+// Myers' algorithm. Applying the script in order, keeping equal elements,
+// skipping deleted ones and adding inserted ones, yields b. It runs in
+// O((N+M)·D) time, where D is the number of inserted and deleted elements, so
+// it is fast for similar inputs, and uses O(N+M) space regardless of D.
+//
+// Example:
+//
+//	edits := Diff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+//	// Result: equal "a", delete "b", equal "c", insert "d"
+func Diff[T comparable](a, b []T) []Edit[T] {
+	return DiffBy(func(x, y T) bool { return x == y }, a, b)
+}
+
+// DiffBy computes the shortest edit script that turns slice a into slice b, like
+// Diff, using a custom equality function. Equal elements are reported with the
+// value from a.
+//
+// Example:
+//
+//	edits := DiffBy(strings.EqualFold, []string{"Go", "Rust"}, []string{"go", "Zig"})
+//	// Result: equal "Go", delete "Rust", insert "Zig"
+func DiffBy[T any](eq func(T, T) bool, a, b []T) []Edit[T] {
+	size := (len(a)+len(b)+1)/2 + 1
+	d := &differ[T]{
+		eq:      eq,
+		a:       a,
+		b:       b,
+		forward: make([]int, 2*size+1),
+		reverse: make([]int, 2*size+1),
+		offset:  size,
+		edits:   make([]Edit[T], 0, max(len(a), len(b))),
+	}
+	d.diff(0, len(a), 0, len(b))
+	return d.edits
+}
+
+// differ holds the state of the linear-space variant of Myers' algorithm, which
+// finds the middle snake of an optimal path and recurses on both halves. The
+// furthest-reaching arrays are shared by every step of the recursion.
+type differ[T any] struct {
+	eq      func(T, T) bool
+	a, b    []T
+	forward []int
+	reverse []int
+	offset  int
+	edits   []Edit[T]
+}
+
+// diff appends the edit script for a[aLo:aHi] and b[bLo:bHi].
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aLo], d.b[bLo]) {
+		d.equal(aLo, bLo)
+		aLo++
+		bLo++
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.eq(d.a[aHi-1-suffix], d.b[bHi-1-suffix]) {
+		suffix++
+	}
+	aHi -= suffix
+	bHi -= suffix
+
+	switch {
+	case aLo == aHi:
+		for y := bLo; y < bHi; y++ {
+			d.edits = append(d.edits, Edit[T]{Kind: EditInsert, AIndex: -1, BIndex: y, Value: d.b[y]})
+		}
+	case bLo == bHi:
+		for x := aLo; x < aHi; x++ {
+			d.edits = append(d.edits, Edit[T]{Kind: EditDelete, AIndex: x, BIndex: -1, Value: d.a[x]})
+		}
+	default:
+		// With matching ends trimmed, at least two edits remain, so both halves
+		// are strictly smaller problems
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.diff(aLo, x, bLo, y)
+		for ; x < u; x, y = x+1, y+1 {
+			d.equal(x, y)
+		}
+		d.diff(u, aHi, v, bHi)
+	}
+
+	for i := range suffix {
+		d.equal(aHi+i, bHi+i)
+	}
+}
+
+// equal appends an edit keeping a[x], which matches b[y].
+func (d *differ[T]) equal(x, y int) {
+	d.edits = append(d.edits, Edit[T]{Kind: EditEqual, AIndex: x, BIndex: y, Value: d.a[x]})
+}
+
+// middleSnake searches an optimal path through a[aLo:aHi] and b[bLo:bHi] from
+// both ends at once and returns the snake where the two searches meet, as the
+// start (x, y) and end (u, v) of a run of matching elements, possibly empty.
+func (d *differ[T]) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	fw, rv, off := d.forward, d.reverse, d.offset
+	fw[off+1], rv[off+1] = 0, 0
+
+	for dist := 0; dist <= (n+m+1)/2; dist++ {
+		// Forward search: x and y count matched elements from the start
+		for k := -dist; k <= dist; k += 2 {
+			var px int
+			if k == -dist || (k != dist && fw[off+k-1] < fw[off+k+1]) {
+				px = fw[off+k+1]
+			} else {
+				px = fw[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < n && py < m && d.eq(d.a[aLo+px], d.b[bLo+py]) {
+				px++
+				py++
+			}
+			fw[off+k] = px
+			if rk := delta - k; odd && rk >= -(dist-1) && rk <= dist-1 && px+rv[off+rk] >= n {
+				return aLo + sx, bLo + sy, aLo + px, bLo + py
+			}
+		}
+
+		// Reverse search: x and y count matched elements from the end
+		for k := -dist; k <= dist; k += 2 {
+			var px int
+			if k == -dist || (k != dist && rv[off+k-1] < rv[off+k+1]) {
+				px = rv[off+k+1]
+			} else {
+				px = rv[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < n && py < m && d.eq(d.a[aHi-1-px], d.b[bHi-1-py]) {
+				px++
+				py++
+			}
+			rv[off+k] = px
+			if fk := delta - k; !odd && fk >= -dist && fk <= dist && px+fw[off+fk] >= n {
+				return aHi - px, bHi - py, aHi - sx, bHi - sy
+			}
+		}
+	}
+	panic("rslice: diff search did not meet")
+}
+
+// LongestCommonSubsequence returns the longest sequence of elements that appear
+// in both slices in the same relative order, not necessarily adjacent.
+// It is derived from the edit script computed by Diff.
+//
+// Example:
+//
+//	lcs := LongestCommonSubsequence([]int{1, 2, 3, 4, 5}, []int{2, 4, 6, 5})
+//	// Result: []int{2, 4, 5}
+func LongestCommonSubsequence[T comparable](a, b []T) []T {
+	result := []T{}
+	for _, e := range Diff(a, b) {
+		if e.Kind == EditEqual {
+			result = append(result, e.Value)
+		}
+	}
+	return result
+}
+
+// FormatUnified renders an edit script as unified-diff style text. Changes are
+// grouped into hunks with the given number of unchanged context lines around
+// them, each introduced by an "@@ -start,count +start,count @@" header. Lines
+// are prefixed with " ", "-" or "+" and elements are formatted with %v.
+// An edit script without changes renders as an empty string.
+//
+// Example:
+//
+//	edits := Diff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+//	fmt.Print(FormatUnified(1, edits))
+//	// Output:
+//	// @@ -1,3 +1,3 @@
+//	//  a
+//	// -b
+//	//  c
+//	// +d
+func FormatUnified[T any](context int, edits []Edit[T]) string {
+	context = max(0, context)
+
+	// aPos and bPos hold, for each edit, how many elements of a and b precede it
+	aPos := make([]int, len(edits)+1)
+	bPos := make([]int, len(edits)+1)
+	var changes []int
+	for i, e := range edits {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+		if e.Kind != EditInsert {
+			aPos[i+1]++
+		}
+		if e.Kind != EditDelete {
+			bPos[i+1]++
+		}
+		if e.Kind != EditEqual {
+			changes = append(changes, i)
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(changes); {
+		// Extend the hunk while the next change is close enough to share context
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*context+1 {
+			j++
+		}
+		start := max(0, changes[i]-context)
+		end := min(len(edits), changes[j]+context+1)
+
+		aStart, aCount := aPos[start], aPos[end]-aPos[start]
+		bStart, bCount := bPos[start], bPos[end]-bPos[start]
+		if aCount > 0 {
+			aStart++
+		}
+		if bCount > 0 {
+			bStart++
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount)
+
+		for _, e := range edits[start:end] {
+			prefix := " "
+			switch e.Kind {
+			case EditInsert:
+				prefix = "+"
+			case EditDelete:
+				prefix = "-"
+			}
+			fmt.Fprintf(&sb, "%s%v\n", prefix, e.Value)
+		}
+		i = j + 1
+	}
+	return sb.String()
+}
@@ -0,0 +1,154 @@
+package rslice
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// applyEdits rebuilds both sides of an edit script, checking that indices line up.
+func applyEdits[T any](t *testing.T, edits []Edit[T], a, b []T) ([]T, []T) {
+	var gotA, gotB []T
+	for _, e := range edits {
+		switch e.Kind {
+		case EditEqual:
+			assert.Equal(t, len(gotA), e.AIndex)
+			assert.Equal(t, len(gotB), e.BIndex)
+			gotA = append(gotA, a[e.AIndex])
+			gotB = append(gotB, b[e.BIndex])
+		case EditDelete:
+			assert.Equal(t, len(gotA), e.AIndex)
+			assert.Equal(t, -1, e.BIndex)
+			gotA = append(gotA, e.Value)
+		case EditInsert:
+			assert.Equal(t, -1, e.AIndex)
+			assert.Equal(t, len(gotB), e.BIndex)
+			gotB = append(gotB, e.Value)
+		}
+	}
+	return gotA, gotB
+}
+
+func editKinds[T any](edits []Edit[T]) string {
+	var sb strings.Builder
+	for _, e := range edits {
+		sb.WriteString(map[EditKind]string{EditEqual: "=", EditInsert: "+", EditDelete: "-"}[e.Kind])
+	}
+	return sb.String()
+}
+
+func TestDiff(t *testing.T) {
+	a := []string{"a", "b", "c"}
+	b := []string{"a", "c", "d"}
+	edits := Diff(a, b)
+
+	assert.Equal(t, []Edit[string]{
+		{Kind: EditEqual, AIndex: 0, BIndex: 0, Value: "a"},
+		{Kind: EditDelete, AIndex: 1, BIndex: -1, Value: "b"},
+		{Kind: EditEqual, AIndex: 2, BIndex: 1, Value: "c"},
+		{Kind: EditInsert, AIndex: -1, BIndex: 2, Value: "d"},
+	}, edits)
+
+	// Classic example from Myers' paper: D = 5
+	x := strings.Split("ABCABBA", "")
+	y := strings.Split("CBABAC", "")
+	edits = Diff(x, y)
+	assert.Equal(t, 5, len(Filter(func(e Edit[string]) bool { return e.Kind != EditEqual }, edits)))
+	gotA, gotB := applyEdits(t, edits, x, y)
+	assert.Equal(t, x, gotA)
+	assert.Equal(t, y, gotB)
+
+	// Edge cases
+	assert.Equal(t, "", editKinds(Diff([]int{}, []int{})))
+	assert.Equal(t, "++", editKinds(Diff([]int{}, []int{1, 2})))
+	assert.Equal(t, "--", editKinds(Diff([]int{1, 2}, []int{})))
+	assert.Equal(t, "===", editKinds(Diff([]int{1, 2, 3}, []int{1, 2, 3})))
+	assert.Equal(t, "-+", editKinds(Diff([]int{1}, []int{2})))
+}
+
+func TestDiffRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < 200; i++ {
+		a := SampleNWithReplacement(rng, rng.IntN(12), []int{1, 2, 3, 4})
+		b := SampleNWithReplacement(rng, rng.IntN(12), []int{1, 2, 3, 4})
+		edits := Diff(a, b)
+
+		gotA, gotB := applyEdits(t, edits, a, b)
+		assert.Equal(t, a, append([]int{}, gotA...))
+		assert.Equal(t, b, append([]int{}, gotB...))
+
+		// The script is minimal, so its equal elements form a longest common subsequence
+		assert.Equal(t, lcsLength(a, b), len(LongestCommonSubsequence(a, b)))
+	}
+}
+
+func TestDiffLargeDistance(t *testing.T) {
+	// Disjoint inputs have the largest possible D; memory must stay linear in N+M
+	a := Range(0, 4000)
+	b := Range(4000, 8000)
+	// A few shared elements keep the path from being trivial
+	b[1000], b[3000] = a[500], a[2500]
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	gotA, gotB := applyEdits(t, edits, a, b)
+	assert.Equal(t, a, gotA)
+	assert.Equal(t, b, gotB)
+	assert.Equal(t, 2, strings.Count(editKinds(edits), "="))
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(8<<20))
+}
+
+// lcsLength is a reference dynamic-programming LCS length.
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func TestDiffBy(t *testing.T) {
+	edits := DiffBy(strings.EqualFold, []string{"Go", "Rust"}, []string{"go", "Zig"})
+	assert.Equal(t, "=-+", editKinds(edits))
+	assert.Equal(t, "Go", edits[0].Value)
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	assert.Equal(t, []int{2, 4, 5}, LongestCommonSubsequence([]int{1, 2, 3, 4, 5}, []int{2, 4, 6, 5}))
+	assert.Equal(t, []int{}, LongestCommonSubsequence([]int{1, 2}, []int{3, 4}))
+	assert.Equal(t, []int{}, LongestCommonSubsequence([]int{}, []int{1}))
+}
+
+func TestFormatUnified(t *testing.T) {
+	edits := Diff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+	expected := "@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n"
+	assert.Equal(t, expected, FormatUnified(1, edits))
+
+	// Distant changes produce separate hunks
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	b := []int{1, 20, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	expected = "@@ -1,3 +1,3 @@\n 1\n-2\n+20\n 3\n" +
+		"@@ -10,1 +10,2 @@\n 10\n+11\n"
+	assert.Equal(t, expected, FormatUnified(1, Diff(a, b)))
+
+	// Insertion into an empty slice
+	assert.Equal(t, "@@ -0,0 +1,1 @@\n+x\n", FormatUnified(3, Diff([]string{}, []string{"x"})))
+
+	// No changes
+	assert.Equal(t, "", FormatUnified(3, Diff(a, a)))
+}
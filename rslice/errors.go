@@ -0,0 +1,252 @@
+package rslice
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ElementError wraps an error returned by a callback together with the index of
+// the element that produced it. The *Err functions return it directly, and the
+// *ErrAll functions join one ElementError per failing element with errors.Join,
+// so errors.As and errors.Is work on both.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// LeafTypeError is returned by FlattenDeep and FlattenDepth when some leaves are
+// not of the requested type. Those leaves are left out of the result; Got lists
+// their distinct types in order of first occurrence, with nil standing for a nil
+// leaf.
+type LeafTypeError struct {
+	Want    reflect.Type
+	Skipped int
+	Got     []reflect.Type
+}
+
+// Error implements the error interface.
+func (e *LeafTypeError) Error() string {
+	got := make([]string, len(e.Got))
+	for i, t := range e.Got {
+		got[i] = "nil"
+		if t != nil {
+			got[i] = t.String()
+		}
+	}
+	return fmt.Sprintf("skipped %d leaves not of type %v: got %s", e.Skipped, e.Want, strings.Join(got, ", "))
+}
+
+// add records a skipped leaf.
+func (e *LeafTypeError) add(leaf reflect.Value) {
+	e.Skipped++
+	var t reflect.Type
+	if leaf.IsValid() {
+		t = leaf.Type()
+	}
+	if !slices.Contains(e.Got, t) {
+		e.Got = append(e.Got, t)
+	}
+}
+
+// DuplicateKeyError is returned by IndexByStrict when several elements produce
+// the same key. It lists every duplicated key, in order of first occurrence,
+// with the positions of all the elements that produced it.
+type DuplicateKeyError[K comparable] struct {
+	Duplicates []struct {
+		Key       K
+		Positions []int
+	}
+}
+
+// Error implements the error interface.
+func (e *DuplicateKeyError[K]) Error() string {
+	parts := make([]string, len(e.Duplicates))
+	for i, d := range e.Duplicates {
+		parts[i] = fmt.Sprintf("%v at positions %v", d.Key, d.Positions)
+	}
+	return "duplicate keys: " + strings.Join(parts, "; ")
+}
+
+// MapErr applies a function that may fail to each element of a slice.
+// It stops at the first error and returns it wrapped in an *ElementError;
+// in that case the returned slice is nil.
+//
+// Example:
+//
+//	parsed, err := MapErr(strconv.Atoi, []string{"1", "x", "3"})
+//	// Result: parsed = nil, err = element 1: strconv.Atoi: parsing "x": invalid syntax
+func MapErr[T, R any](fn func(T) (R, error), slice []T) ([]R, error) {
+	result := make([]R, len(slice))
+	for i, v := range slice {
+		r, err := fn(v)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// MapErrAll applies a function that may fail to every element of a slice.
+// All elements are processed; failures leave the zero value at their position
+// and are returned as a single error built with errors.Join.
+//
+// Example:
+//
+//	parsed, err := MapErrAll(strconv.Atoi, []string{"1", "x", "3", "y"})
+//	// Result: parsed = []int{1, 0, 3, 0}, err reports elements 1 and 3
+func MapErrAll[T, R any](fn func(T) (R, error), slice []T) ([]R, error) {
+	result := make([]R, len(slice))
+	var errs []error
+	for i, v := range slice {
+		r, err := fn(v)
+		if err != nil {
+			errs = append(errs, &ElementError{Index: i, Err: err})
+			continue
+		}
+		result[i] = r
+	}
+	return result, errors.Join(errs...)
+}
+
+// FilterErr keeps the elements for which a predicate that may fail returns true.
+// It stops at the first error and returns it wrapped in an *ElementError;
+// in that case the returned slice is nil.
+//
+// Example:
+//
+//	valid, err := FilterErr(func(s string) (bool, error) {
+//		n, err := strconv.Atoi(s)
+//		return n > 1, err
+//	}, []string{"1", "2", "3"})
+//	// Result: valid = []string{"2", "3"}, err = nil
+func FilterErr[T any](fn func(T) (bool, error), slice []T) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	for i, v := range slice {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// FilterErrAll keeps the elements for which a predicate that may fail returns true.
+// All elements are processed; failing elements are excluded from the result and
+// reported as a single error built with errors.Join.
+func FilterErrAll[T any](fn func(T) (bool, error), slice []T) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	var errs []error
+	for i, v := range slice {
+		ok, err := fn(v)
+		if err != nil {
+			errs = append(errs, &ElementError{Index: i, Err: err})
+			continue
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// ReduceErr folds a slice with a function that may fail.
+// It stops at the first error and returns the accumulator as it was before the
+// failing element, together with the error wrapped in an *ElementError.
+//
+// Example:
+//
+//	sum, err := ReduceErr(func(acc int, s string) (int, error) {
+//		n, err := strconv.Atoi(s)
+//		return acc + n, err
+//	}, 0, []string{"1", "2", "3"})
+//	// Result: sum = 6, err = nil
+func ReduceErr[T, R any](fn func(R, T) (R, error), initial R, slice []T) (R, error) {
+	result := initial
+	for i, v := range slice {
+		next, err := fn(result, v)
+		if err != nil {
+			return result, &ElementError{Index: i, Err: err}
+		}
+		result = next
+	}
+	return result, nil
+}
+
+// ReduceErrAll folds a slice with a function that may fail.
+// All elements are processed; a failing element leaves the accumulator unchanged
+// and its error is reported in a single error built with errors.Join.
+func ReduceErrAll[T, R any](fn func(R, T) (R, error), initial R, slice []T) (R, error) {
+	result := initial
+	var errs []error
+	for i, v := range slice {
+		next, err := fn(result, v)
+		if err != nil {
+			errs = append(errs, &ElementError{Index: i, Err: err})
+			continue
+		}
+		result = next
+	}
+	return result, errors.Join(errs...)
+}
+
+// FindErr returns the first element for which a predicate that may fail returns true.
+// It stops at the first error and returns it wrapped in an *ElementError.
+//
+// Example:
+//
+//	found, ok, err := FindErr(func(s string) (bool, error) {
+//		n, err := strconv.Atoi(s)
+//		return n > 1, err
+//	}, []string{"1", "2", "3"})
+//	// Result: found = "2", ok = true, err = nil
+func FindErr[T any](fn func(T) (bool, error), slice []T) (T, bool, error) {
+	var zero T
+	for i, v := range slice {
+		ok, err := fn(v)
+		if err != nil {
+			return zero, false, &ElementError{Index: i, Err: err}
+		}
+		if ok {
+			return v, true, nil
+		}
+	}
+	return zero, false, nil
+}
+
+// FindErrAll returns the first element for which a predicate that may fail returns true.
+// Like the other ErrAll functions, the predicate runs on every element, even after
+// a match, so that every failure is reported in a single error built with
+// errors.Join alongside the match.
+func FindErrAll[T any](fn func(T) (bool, error), slice []T) (T, bool, error) {
+	var found T
+	var matched bool
+	var errs []error
+	for i, v := range slice {
+		ok, err := fn(v)
+		if err != nil {
+			errs = append(errs, &ElementError{Index: i, Err: err})
+			continue
+		}
+		if ok && !matched {
+			found, matched = v, true
+		}
+	}
+	return found, matched, errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+package rslice
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNegative = errors.New("negative")
+
+func checkPositive(n int) (bool, error) {
+	if n < 0 {
+		return false, errNegative
+	}
+	return n > 0, nil
+}
+
+func TestMapErr(t *testing.T) {
+	parsed, err := MapErr(strconv.Atoi, []string{"1", "2", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, parsed)
+
+	calls := 0
+	parsed, err = MapErr(func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}, []string{"1", "x", "3"})
+	assert.Nil(t, parsed)
+	assert.Equal(t, 2, calls)
+
+	var elemErr *ElementError
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 1, elemErr.Index)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestMapErrAll(t *testing.T) {
+	parsed, err := MapErrAll(strconv.Atoi, []string{"1", "x", "3", "y"})
+	assert.Equal(t, []int{1, 0, 3, 0}, parsed)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+	assert.Contains(t, err.Error(), "element 1")
+	assert.Contains(t, err.Error(), "element 3")
+
+	parsed, err = MapErrAll(strconv.Atoi, []string{"4"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4}, parsed)
+}
+
+func TestFilterErr(t *testing.T) {
+	result, err := FilterErr(checkPositive, []int{0, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, result)
+
+	result, err = FilterErr(checkPositive, []int{1, -1, 2, -2})
+	assert.Nil(t, result)
+	var elemErr *ElementError
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 1, elemErr.Index)
+	assert.ErrorIs(t, err, errNegative)
+}
+
+func TestFilterErrAll(t *testing.T) {
+	result, err := FilterErrAll(checkPositive, []int{1, -1, 2, -2, 0})
+	assert.Equal(t, []int{1, 2}, result)
+	assert.ErrorIs(t, err, errNegative)
+	assert.Contains(t, err.Error(), "element 1")
+	assert.Contains(t, err.Error(), "element 3")
+}
+
+func TestReduceErr(t *testing.T) {
+	add := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}
+
+	sum, err := ReduceErr(add, 0, []string{"1", "2", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, 6, sum)
+
+	sum, err = ReduceErr(add, 0, []string{"1", "2", "x", "4"})
+	assert.Equal(t, 3, sum)
+	var elemErr *ElementError
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 2, elemErr.Index)
+}
+
+func TestReduceErrAll(t *testing.T) {
+	add := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, err
+		}
+		return acc + n, nil
+	}
+
+	sum, err := ReduceErrAll(add, 0, []string{"1", "x", "3", "y"})
+	assert.Equal(t, 4, sum)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Contains(t, err.Error(), "element 1")
+	assert.Contains(t, err.Error(), "element 3")
+}
+
+func TestFindErr(t *testing.T) {
+	found, ok, err := FindErr(checkPositive, []int{0, 2, 3})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, found)
+
+	_, ok, err = FindErr(checkPositive, []int{0, -1, 3})
+	assert.False(t, ok)
+	var elemErr *ElementError
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 1, elemErr.Index)
+
+	_, ok, err = FindErr(checkPositive, []int{0, 0})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestFindErrAll(t *testing.T) {
+	found, ok, err := FindErrAll(checkPositive, []int{0, -1, 3, -4})
+	assert.True(t, ok)
+	assert.Equal(t, 3, found)
+	assert.ErrorIs(t, err, errNegative)
+	assert.Contains(t, err.Error(), "element 1")
+	// Elements after the match are still checked
+	assert.Contains(t, err.Error(), "element 3")
+
+	calls := 0
+	found, ok, err = FindErrAll(func(n int) (bool, error) {
+		calls++
+		return n > 0, nil
+	}, []int{1, 2, 3})
+	assert.Equal(t, 1, found)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	_, ok, err = FindErrAll(checkPositive, []int{-1, 0})
+	assert.False(t, ok)
+	assert.ErrorIs(t, err, errNegative)
+}
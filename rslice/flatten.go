@@ -0,0 +1,96 @@
+package rslice
+
+import "reflect"
+
+// FlatMap applies a function that returns a slice to each element and
+// concatenates the results into a single slice.
+//
+// Example:
+//
+//	words := []string{"go", "is"}
+//	letters := FlatMap(func(s string) []string { return strings.Split(s, "") }, words)
+//	// Result: []string{"g", "o", "i", "s"}
+func FlatMap[T, R any](fn func(T) []R, slice []T) []R {
+	result := make([]R, 0, len(slice))
+	for _, v := range slice {
+		result = append(result, fn(v)...)
+	}
+	return result
+}
+
+// FlattenDeep flattens nested slices and arrays of any depth into a single slice.
+// It uses reflection, so the input can mix nesting levels, including []any values.
+// Interface values are unwrapped before inspection, but leaves are not converted:
+// a leaf that is not of type R, including nil, is left out and reported in a
+// *LeafTypeError alongside the leaves that matched. In particular, numbers decoded
+// by encoding/json into any are float64, so flatten them with FlattenDeep[float64].
+// If the input itself is not a slice or array, the result is empty.
+//
+// Example:
+//
+//	nested := []any{1, []any{2, []int{3, 4}}, [][]int{{5}}}
+//	flat, err := FlattenDeep[int](nested)
+//	// Result: flat = []int{1, 2, 3, 4, 5}, err = nil
+func FlattenDeep[R any](slice any) ([]R, error) {
+	return FlattenDepth[R](-1, slice)
+}
+
+// FlattenDepth flattens nested slices and arrays up to the given depth.
+// A depth of 1 behaves like Flatten, a depth of 2 removes two levels of nesting,
+// and so on; a negative depth flattens completely like FlattenDeep.
+// Values remaining after depth levels must be of type R; as with FlattenDeep,
+// any others are left out and reported in a *LeafTypeError.
+//
+// Example:
+//
+//	nested := [][][]int{{{1, 2}, {3}}, {{4}}}
+//	rows, err := FlattenDepth[[]int](1, nested)
+//	// Result: rows = [][]int{{1, 2}, {3}, {4}}, err = nil
+func FlattenDepth[R any](depth int, slice any) ([]R, error) {
+	result := []R{}
+	v := unwrapInterface(reflect.ValueOf(slice))
+	if !isList(v) {
+		return result, nil
+	}
+
+	mismatch := &LeafTypeError{Want: reflect.TypeFor[R]()}
+	result = flattenValue(v, depth, result, mismatch)
+	if mismatch.Skipped > 0 {
+		return result, mismatch
+	}
+	return result, nil
+}
+
+// flattenValue appends the elements of the list v to result, descending into
+// nested lists while depth is non-zero. Leaves that are not of type R are
+// recorded in mismatch.
+func flattenValue[R any](v reflect.Value, depth int, result []R, mismatch *LeafTypeError) []R {
+	for i := 0; i < v.Len(); i++ {
+		elem := unwrapInterface(v.Index(i))
+		if depth != 0 && isList(elem) {
+			result = flattenValue(elem, depth-1, result, mismatch)
+			continue
+		}
+		if elem.IsValid() && elem.CanInterface() {
+			if r, ok := elem.Interface().(R); ok {
+				result = append(result, r)
+				continue
+			}
+		}
+		mismatch.add(elem)
+	}
+	return result
+}
+
+// unwrapInterface returns the concrete value held by an interface value.
+func unwrapInterface(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// isList reports whether v is a slice or an array.
+func isList(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+}
@@ -0,0 +1,94 @@
+package rslice
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatMap(t *testing.T) {
+	words := []string{"go", "is"}
+	letters := FlatMap(func(s string) []string { return strings.Split(s, "") }, words)
+	assert.Equal(t, []string{"g", "o", "i", "s"}, letters)
+
+	repeated := FlatMap(func(n int) []int { return Take(n, []int{n, n, n}) }, []int{0, 1, 2})
+	assert.Equal(t, []int{1, 2, 2}, repeated)
+
+	assert.Equal(t, []int{}, FlatMap(func(n int) []int { return []int{n} }, []int{}))
+}
+
+func TestFlattenDeep(t *testing.T) {
+	nested := []any{1, []any{2, []int{3, 4}}, [][]int{{5}}, [2]int{6, 7}}
+	flat, err := FlattenDeep[int](nested)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, flat)
+
+	// Typed nesting
+	flat, err = FlattenDeep[int]([][][]int{{{1, 2}}, {{3}, {4}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, flat)
+
+	// Leaves of other types and nil values are left out and reported
+	mixed := []any{"a", 1, nil, []any{"b", 2.5, 3}}
+	words, err := FlattenDeep[string](mixed)
+	assert.Equal(t, []string{"a", "b"}, words)
+	var mismatch *LeafTypeError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, 4, mismatch.Skipped)
+	assert.Equal(t, []reflect.Type{reflect.TypeFor[int](), nil, reflect.TypeFor[float64]()}, mismatch.Got)
+	assert.EqualError(t, err, "skipped 4 leaves not of type string: got int, nil, float64")
+
+	// Numbers decoded from JSON are float64, not int
+	var decoded any
+	require.NoError(t, json.Unmarshal([]byte(`[1, [2, [3]]]`), &decoded))
+	ints, err := FlattenDeep[int](decoded)
+	assert.Empty(t, ints)
+	assert.ErrorAs(t, err, &mismatch)
+	floats, err := FlattenDeep[float64](decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, floats)
+
+	// Non-slice input
+	flat, err = FlattenDeep[int](42)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, flat)
+	flat, err = FlattenDeep[int](nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, flat)
+}
+
+func TestFlattenDepth(t *testing.T) {
+	nested := [][][]int{{{1, 2}, {3}}, {{4}}}
+
+	rows, err := FlattenDepth[[]int](1, nested)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {3}, {4}}, rows)
+
+	flat, err := FlattenDepth[int](2, nested)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, flat)
+
+	flat, err = FlattenDepth[int](-1, nested)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, flat)
+
+	same, err := FlattenDepth[[][]int](0, nested)
+	assert.NoError(t, err)
+	assert.Equal(t, [][][]int{{{1, 2}, {3}}, {{4}}}, same)
+
+	// Lists remaining at the depth limit are leaves too
+	_, err = FlattenDepth[int](1, nested)
+	var mismatch *LeafTypeError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, 3, mismatch.Skipped)
+
+	// Matches Flatten for one level
+	matrix := [][]int{{1, 2}, {3, 4}, {5}}
+	flat, err = FlattenDepth[int](1, matrix)
+	assert.NoError(t, err)
+	assert.Equal(t, Flatten(matrix), flat)
+}
@@ -0,0 +1,167 @@
+package rslice
+
+import "math"
+
+// Range returns the numbers from start up to, but not including, end, counting
+// by one. If end is not greater than start the result is empty.
+//
+// Example:
+//
+//	numbers := Range(1, 5)
+//	// Result: []int{1, 2, 3, 4}
+func Range[T Number](start, end T) []T {
+	return RangeStep(start, end, 1)
+}
+
+// RangeStep returns the numbers from start up to, but not including, end,
+// counting by step. A negative step counts down, in which case end must be less
+// than start. A zero step, or a step pointing away from end, gives an empty
+// result, as does a NaN or infinite bound or step. Values are computed as
+// start + i*step, so float ranges do not accumulate rounding error.
+//
+// Example:
+//
+//	evens := RangeStep(0, 10, 2)
+//	// Result: []int{0, 2, 4, 6, 8}
+//
+//	countdown := RangeStep(3, 0, -1)
+//	// Result: []int{3, 2, 1}
+func RangeStep[T Number](start, end, step T) []T {
+	var zero T
+	if step == zero || (step > zero && start >= end) || (step < zero && start <= end) {
+		return []T{}
+	}
+
+	var count int
+	if T(1)/T(2) == zero {
+		// Integer types: count in uint64, which holds the distance between any
+		// two values of T exactly, unlike float64
+		span, stride := uint64(end)-uint64(start), uint64(step)
+		if step < zero {
+			span, stride = -span, -stride
+		}
+		count = int((span-1)/stride + 1)
+	} else {
+		n := math.Ceil((float64(end) - float64(start)) / float64(step))
+		if isNonFinite(float64(start)) || isNonFinite(float64(end)) || isNonFinite(float64(step)) || isNonFinite(n) {
+			return []T{}
+		}
+		count = int(n)
+
+		// Rounding in the division can let the last value reach end; drop it
+		for count > 0 {
+			last := start + T(count-1)*step
+			if (step > zero && last < end) || (step < zero && last > end) {
+				break
+			}
+			count--
+		}
+	}
+
+	result := make([]T, count)
+	for i := range result {
+		result[i] = start + T(i)*step
+	}
+	return result
+}
+
+// isNonFinite reports whether f is NaN or an infinity.
+func isNonFinite(f float64) bool {
+	return math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+// Repeat returns a slice containing the value n times.
+// An n less than or equal to zero gives an empty result.
+//
+// Example:
+//
+//	dashes := Repeat("-", 3)
+//	// Result: []string{"-", "-", "-"}
+func Repeat[T any](value T, n int) []T {
+	return Times(n, func(int) T { return value })
+}
+
+// Times calls a function with each index from 0 to n-1 and returns the results.
+// An n less than or equal to zero gives an empty result.
+//
+// Example:
+//
+//	squares := Times(4, func(i int) int { return i * i })
+//	// Result: []int{0, 1, 4, 9}
+func Times[T any](n int, fn func(int) T) []T {
+	result := make([]T, max(0, n))
+	for i := range result {
+		result[i] = fn(i)
+	}
+	return result
+}
+
+// Intersperse returns a new slice with the separator inserted between each pair
+// of adjacent elements.
+//
+// Example:
+//
+//	parts := Intersperse(",", []string{"a", "b", "c"})
+//	// Result: []string{"a", ",", "b", ",", "c"}
+func Intersperse[T any](separator T, slice []T) []T {
+	if len(slice) == 0 {
+		return []T{}
+	}
+
+	result := make([]T, 0, 2*len(slice)-1)
+	for i, v := range slice {
+		if i > 0 {
+			result = append(result, separator)
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Interleave returns a new slice that takes one element from each slice in turn.
+// When a slice runs out, the remaining slices keep taking turns.
+//
+// Example:
+//
+//	mixed := Interleave([]int{1, 2, 3}, []int{10, 20}, []int{100})
+//	// Result: []int{1, 10, 100, 2, 20, 3}
+func Interleave[T any](lists ...[]T) []T {
+	total, longest := 0, 0
+	for _, s := range lists {
+		total += len(s)
+		longest = max(longest, len(s))
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range lists {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+	return result
+}
+
+// Rotate returns a new slice with the elements shifted left by k positions,
+// wrapping around, so the element at index k comes first. A negative k shifts
+// right, and k may be larger than the length of the slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	left := Rotate(2, numbers)
+//	// Result: []int{3, 4, 5, 1, 2}
+//	right := Rotate(-1, numbers)
+//	// Result: []int{5, 1, 2, 3, 4}
+func Rotate[T any](k int, slice []T) []T {
+	if len(slice) == 0 {
+		return []T{}
+	}
+
+	k %= len(slice)
+	if k < 0 {
+		k += len(slice)
+	}
+	return append(append(make([]T, 0, len(slice)), slice[k:]...), slice[:k]...)
+}
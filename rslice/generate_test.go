@@ -0,0 +1,91 @@
+package rslice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, Range(1, 5))
+	assert.Equal(t, []int{-2, -1, 0}, Range(-2, 1))
+	assert.Equal(t, []int{}, Range(5, 5))
+	assert.Equal(t, []int{}, Range(5, 1))
+	assert.Equal(t, []float64{0.5, 1.5, 2.5}, Range(0.5, 3.0))
+	assert.Equal(t, []uint8{250, 251, 252}, Range[uint8](250, 253))
+}
+
+func TestRangeStep(t *testing.T) {
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, RangeStep(0, 10, 2))
+	assert.Equal(t, []int{0, 3, 6, 9}, RangeStep(0, 10, 3))
+	assert.Equal(t, []int{3, 2, 1}, RangeStep(3, 0, -1))
+	assert.Equal(t, []int{}, RangeStep(0, 10, 0))
+	assert.Equal(t, []int{}, RangeStep(0, 10, -1))
+	assert.Equal(t, []int{}, RangeStep(10, 0, 1))
+
+	floats := RangeStep(0.0, 1.0, 0.1)
+	assert.Len(t, floats, 10)
+	assert.InDelta(t, 0.9, floats[9], 1e-9)
+
+	// Rounding in the count must not let end into the result
+	assert.Len(t, RangeStep(1.0, 1.3, 0.1), 3)
+	assert.Len(t, RangeStep(1.3, 1.0, -0.1), 3)
+	for s := 0; s < 100; s++ {
+		for e := s + 1; e < 100; e++ {
+			values := RangeStep(float64(s)/10, float64(e)/10, 0.1)
+			assert.Less(t, values[len(values)-1], float64(e)/10)
+
+			values = RangeStep(float64(e)/10, float64(s)/10, -0.1)
+			assert.Greater(t, values[len(values)-1], float64(s)/10)
+		}
+	}
+
+	// Non-finite float bounds or steps give an empty result
+	assert.Equal(t, []float64{}, RangeStep(0, math.NaN(), 1.0))
+	assert.Equal(t, []float64{}, RangeStep(0, math.Inf(1), 1.0))
+	assert.Equal(t, []float64{}, RangeStep(math.Inf(-1), 0, 1.0))
+	assert.Equal(t, []float64{}, RangeStep(0, 1, math.NaN()))
+
+	// Integer counts are exact beyond the float64 mantissa
+	x := int64(1<<53 + 1)
+	assert.Equal(t, []int64{x, x + 1, x + 2}, Range(x, x+3))
+	assert.Equal(t, []int64{math.MaxInt64, math.MaxInt64 - 2}, RangeStep[int64](math.MaxInt64, math.MaxInt64-4, -2))
+	assert.Equal(t, []int8{-100, -50, 0, 50}, RangeStep[int8](-100, 100, 50))
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"-", "-", "-"}, Repeat("-", 3))
+	assert.Equal(t, []string{}, Repeat("-", 0))
+	assert.Equal(t, []string{}, Repeat("-", -2))
+}
+
+func TestTimes(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 4, 9}, Times(4, func(i int) int { return i * i }))
+	assert.Equal(t, []int{}, Times(0, func(i int) int { return i }))
+}
+
+func TestIntersperse(t *testing.T) {
+	assert.Equal(t, []string{"a", ",", "b", ",", "c"}, Intersperse(",", []string{"a", "b", "c"}))
+	assert.Equal(t, []string{"a"}, Intersperse(",", []string{"a"}))
+	assert.Equal(t, []string{}, Intersperse(",", []string{}))
+}
+
+func TestInterleave(t *testing.T) {
+	assert.Equal(t, []int{1, 10, 100, 2, 20, 3}, Interleave([]int{1, 2, 3}, []int{10, 20}, []int{100}))
+	assert.Equal(t, []int{1, 2}, Interleave([]int{}, []int{1, 2}))
+	assert.Equal(t, []int{}, Interleave[int]())
+}
+
+func TestRotate(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, []int{3, 4, 5, 1, 2}, Rotate(2, numbers))
+	assert.Equal(t, []int{5, 1, 2, 3, 4}, Rotate(-1, numbers))
+	assert.Equal(t, numbers, Rotate(0, numbers))
+	assert.Equal(t, numbers, Rotate(5, numbers))
+	assert.Equal(t, []int{2, 3, 4, 5, 1}, Rotate(11, numbers))
+	assert.Equal(t, []int{4, 5, 1, 2, 3}, Rotate(-7, numbers))
+	assert.Equal(t, []int{}, Rotate(3, []int{}))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, numbers)
+}
@@ -0,0 +1,90 @@
+package rslice
+
+// GroupByOrdered groups elements of a slice by a key function, like GroupBy, but
+// returns the groups as key/values pairs in the order each key is first seen.
+// Elements keep their original order within each group.
+//
+// Example:
+//
+//	words := []string{"banana", "apple", "blueberry", "avocado"}
+//	grouped := GroupByOrdered(func(s string) byte { return s[0] }, words)
+//	// Result: []struct{Key byte; Values []string}{
+//	//   {'b', []string{"banana", "blueberry"}},
+//	//   {'a', []string{"apple", "avocado"}},
+//	// }
+func GroupByOrdered[T any, K comparable](fn func(T) K, slice []T) []struct {
+	Key    K
+	Values []T
+} {
+	positions := make(map[K]int)
+	result := []struct {
+		Key    K
+		Values []T
+	}{}
+	for _, v := range slice {
+		key := fn(v)
+		pos, exists := positions[key]
+		if !exists {
+			pos = len(result)
+			positions[key] = pos
+			result = append(result, struct {
+				Key    K
+				Values []T
+			}{Key: key})
+		}
+		result[pos].Values = append(result[pos].Values, v)
+	}
+	return result
+}
+
+// GroupByMulti groups elements of a slice under every key returned by the key
+// function, so one element can belong to several groups. An element is added
+// to each group at most once, even if its keys repeat, and elements with no
+// keys belong to no group.
+//
+// Example:
+//
+//	type Post struct{ Title string; Tags []string }
+//	posts := []Post{{"a", []string{"go", "fp"}}, {"b", []string{"go"}}}
+//	byTag := GroupByMulti(func(p Post) []string { return p.Tags }, posts)
+//	// Result: map[string][]Post{"go": {posts[0], posts[1]}, "fp": {posts[0]}}
+func GroupByMulti[T any, K comparable](fn func(T) []K, slice []T) map[K][]T {
+	result := make(map[K][]T)
+	for _, v := range slice {
+		for _, key := range Unique(fn(v)) {
+			result[key] = append(result[key], v)
+		}
+	}
+	return result
+}
+
+// GroupBy2 groups elements of a slice by two key functions into a two-level
+// nested map, first by the outer key and then by the inner key.
+//
+// Example:
+//
+//	type Employee struct{ Name, Region, Team string }
+//	staff := []Employee{{"ann", "eu", "core"}, {"bob", "eu", "web"}, {"cy", "us", "core"}}
+//	grouped := GroupBy2(
+//		func(e Employee) string { return e.Region },
+//		func(e Employee) string { return e.Team },
+//		staff,
+//	)
+//	// Result: map[string]map[string][]Employee{
+//	//   "eu": {"core": {ann}, "web": {bob}},
+//	//   "us": {"core": {cy}},
+//	// }
+func GroupBy2[T any, K1, K2 comparable](fn1 func(T) K1, fn2 func(T) K2, slice []T) map[K1]map[K2][]T {
+	result := make(map[K1]map[K2][]T)
+	for _, v := range slice {
+		outer := fn1(v)
+		inner, exists := result[outer]
+		if !exists {
+			inner = make(map[K2][]T)
+			result[outer] = inner
+		}
+		key := fn2(v)
+		inner[key] = append(inner[key], v)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByOrdered(t *testing.T) {
+	words := []string{"banana", "apple", "blueberry", "avocado", "cherry"}
+	grouped := GroupByOrdered(func(s string) byte { return s[0] }, words)
+
+	assert.Len(t, grouped, 3)
+	assert.Equal(t, byte('b'), grouped[0].Key)
+	assert.Equal(t, []string{"banana", "blueberry"}, grouped[0].Values)
+	assert.Equal(t, byte('a'), grouped[1].Key)
+	assert.Equal(t, []string{"apple", "avocado"}, grouped[1].Values)
+	assert.Equal(t, byte('c'), grouped[2].Key)
+	assert.Equal(t, []string{"cherry"}, grouped[2].Values)
+
+	assert.Empty(t, GroupByOrdered(func(s string) int { return len(s) }, []string{}))
+}
+
+func TestGroupByMulti(t *testing.T) {
+	type Post struct {
+		Title string
+		Tags  []string
+	}
+
+	posts := []Post{
+		{"a", []string{"go", "fp"}},
+		{"b", []string{"go", "go"}},
+		{"c", nil},
+	}
+	byTag := GroupByMulti(func(p Post) []string { return p.Tags }, posts)
+
+	assert.Len(t, byTag, 2)
+	assert.Equal(t, []Post{posts[0], posts[1]}, byTag["go"])
+	assert.Equal(t, []Post{posts[0]}, byTag["fp"])
+}
+
+func TestGroupBy2(t *testing.T) {
+	type Employee struct {
+		Name, Region, Team string
+	}
+
+	staff := []Employee{
+		{"ann", "eu", "core"},
+		{"bob", "eu", "web"},
+		{"cy", "us", "core"},
+		{"dee", "eu", "core"},
+	}
+	grouped := GroupBy2(
+		func(e Employee) string { return e.Region },
+		func(e Employee) string { return e.Team },
+		staff,
+	)
+
+	assert.Len(t, grouped, 2)
+	assert.Equal(t, []Employee{staff[0], staff[3]}, grouped["eu"]["core"])
+	assert.Equal(t, []Employee{staff[1]}, grouped["eu"]["web"])
+	assert.Equal(t, []Employee{staff[2]}, grouped["us"]["core"])
+	assert.Len(t, grouped["us"], 1)
+}
@@ -0,0 +1,88 @@
+package rslice
+
+// IndexByStrict creates a map from a slice using a function to generate keys,
+// like IndexBy, but fails instead of overwriting when keys collide. The error is
+// a *DuplicateKeyError naming each duplicated key and the positions that produced
+// it; in that case the returned map is nil.
+//
+// Example:
+//
+//	users := []User{{ID: "1"}, {ID: "2"}, {ID: "1"}}
+//	indexed, err := IndexByStrict(func(u User) string { return u.ID }, users)
+//	// Result: indexed = nil, err = duplicate keys: 1 at positions [0 2]
+func IndexByStrict[T any, K comparable](fn func(T) K, slice []T) (map[K]T, error) {
+	result := make(map[K]T, len(slice))
+	positions := make(map[K][]int, len(slice))
+	var duplicated []K
+
+	for i, v := range slice {
+		key := fn(v)
+		if _, exists := result[key]; exists {
+			if len(positions[key]) == 1 {
+				duplicated = append(duplicated, key)
+			}
+		} else {
+			result[key] = v
+		}
+		positions[key] = append(positions[key], i)
+	}
+
+	if len(duplicated) == 0 {
+		return result, nil
+	}
+
+	err := &DuplicateKeyError[K]{}
+	for _, key := range duplicated {
+		err.Duplicates = append(err.Duplicates, struct {
+			Key       K
+			Positions []int
+		}{key, positions[key]})
+	}
+	return nil, err
+}
+
+// IndexByFirst creates a map from a slice using a function to generate keys.
+// If multiple elements produce the same key, the first element is kept.
+//
+// Example:
+//
+//	users := []User{{ID: "1", Name: "Alice"}, {ID: "1", Name: "AliceUpdated"}}
+//	indexed := IndexByFirst(func(u User) string { return u.ID }, users)
+//	// Result: map[string]User{"1": {ID: "1", Name: "Alice"}}
+func IndexByFirst[T any, K comparable](fn func(T) K, slice []T) map[K]T {
+	result := make(map[K]T, len(slice))
+	for _, v := range slice {
+		key := fn(v)
+		if _, exists := result[key]; !exists {
+			result[key] = v
+		}
+	}
+	return result
+}
+
+// IndexByMerge creates a map from a slice using a function to generate keys.
+// If multiple elements produce the same key, the merge function is called with
+// the element already stored and the incoming one, and its result is stored.
+//
+// Example:
+//
+//	type Stock struct{ SKU string; Qty int }
+//	stock := []Stock{{"a", 1}, {"b", 2}, {"a", 3}}
+//	indexed := IndexByMerge(
+//		func(s Stock) string { return s.SKU },
+//		func(existing, incoming Stock) Stock { existing.Qty += incoming.Qty; return existing },
+//		stock,
+//	)
+//	// Result: map[string]Stock{"a": {"a", 4}, "b": {"b", 2}}
+func IndexByMerge[T any, K comparable](fn func(T) K, merge func(T, T) T, slice []T) map[K]T {
+	result := make(map[K]T, len(slice))
+	for _, v := range slice {
+		key := fn(v)
+		if existing, exists := result[key]; exists {
+			result[key] = merge(existing, v)
+		} else {
+			result[key] = v
+		}
+	}
+	return result
+}
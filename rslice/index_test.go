@@ -0,0 +1,70 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type indexUser struct {
+	ID   string
+	Name string
+}
+
+func indexUserID(u indexUser) string { return u.ID }
+
+func TestIndexByStrict(t *testing.T) {
+	users := []indexUser{{"1", "Alice"}, {"2", "Bob"}}
+	indexed, err := IndexByStrict(indexUserID, users)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]indexUser{"1": users[0], "2": users[1]}, indexed)
+
+	duplicates := []indexUser{
+		{"1", "Alice"},
+		{"2", "Bob"},
+		{"1", "AliceAgain"},
+		{"3", "Carol"},
+		{"2", "BobAgain"},
+		{"1", "AliceThird"},
+	}
+	indexed, err = IndexByStrict(indexUserID, duplicates)
+	assert.Nil(t, indexed)
+
+	var dupErr *DuplicateKeyError[string]
+	require.ErrorAs(t, err, &dupErr)
+	require.Len(t, dupErr.Duplicates, 2)
+	assert.Equal(t, "1", dupErr.Duplicates[0].Key)
+	assert.Equal(t, []int{0, 2, 5}, dupErr.Duplicates[0].Positions)
+	assert.Equal(t, "2", dupErr.Duplicates[1].Key)
+	assert.Equal(t, []int{1, 4}, dupErr.Duplicates[1].Positions)
+	assert.Equal(t, "duplicate keys: 1 at positions [0 2 5]; 2 at positions [1 4]", err.Error())
+}
+
+func TestIndexByFirst(t *testing.T) {
+	users := []indexUser{{"1", "Alice"}, {"1", "AliceUpdated"}, {"2", "Bob"}}
+	indexed := IndexByFirst(indexUserID, users)
+
+	assert.Len(t, indexed, 2)
+	assert.Equal(t, "Alice", indexed["1"].Name)
+	assert.Equal(t, "Bob", indexed["2"].Name)
+}
+
+func TestIndexByMerge(t *testing.T) {
+	type Stock struct {
+		SKU string
+		Qty int
+	}
+
+	stock := []Stock{{"a", 1}, {"b", 2}, {"a", 3}, {"a", 5}}
+	indexed := IndexByMerge(
+		func(s Stock) string { return s.SKU },
+		func(existing, incoming Stock) Stock {
+			existing.Qty += incoming.Qty
+			return existing
+		},
+		stock,
+	)
+
+	assert.Equal(t, map[string]Stock{"a": {"a", 9}, "b": {"b", 2}}, indexed)
+}
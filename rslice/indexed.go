@@ -0,0 +1,109 @@
+package rslice
+
+// MapIndexed applies a function to each element of a slice along with its index
+// and returns a new slice with the results.
+//
+// Example:
+//
+//	names := []string{"alice", "bob"}
+//	rows := MapIndexed(func(s string, i int) string { return fmt.Sprintf("%d. %s", i+1, s) }, names)
+//	// Result: []string{"1. alice", "2. bob"}
+func MapIndexed[T, R any](fn func(T, int) R, slice []T) []R {
+	result := make([]R, len(slice))
+	for i, v := range slice {
+		result[i] = fn(v, i)
+	}
+	return result
+}
+
+// FilterIndexed creates a new slice containing only the elements that satisfy the
+// predicate function. The predicate receives both the element and its index.
+//
+// Example:
+//
+//	letters := []string{"a", "b", "c", "d", "e"}
+//	evenPositions := FilterIndexed(func(_ string, i int) bool { return i%2 == 0 }, letters)
+//	// Result: []string{"a", "c", "e"}
+func FilterIndexed[T any](fn func(T, int) bool, slice []T) []T {
+	result := make([]T, 0, len(slice))
+	for i, v := range slice {
+		if fn(v, i) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// ReduceIndexed applies a function to each element of a slice along with its index,
+// accumulating the result.
+//
+// Example:
+//
+//	numbers := []int{10, 20, 30}
+//	weighted := ReduceIndexed(func(acc, n, i int) int { return acc + n*i }, 0, numbers)
+//	// Result: 80 (10*0 + 20*1 + 30*2)
+func ReduceIndexed[T, R any](fn func(R, T, int) R, initial R, slice []T) R {
+	result := initial
+	for i, v := range slice {
+		result = fn(result, v, i)
+	}
+	return result
+}
+
+// FindIndex returns the index of the first element that satisfies the predicate
+// function, or -1 if no element matches.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	index := FindIndex(func(n int) bool { return n > 3 }, numbers)
+//	// Result: 3
+func FindIndex[T any](fn func(T) bool, slice []T) int {
+	for i, v := range slice {
+		if fn(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastIndex returns the index of the last element that satisfies the predicate
+// function, or -1 if no element matches.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	index := FindLastIndex(func(n int) bool { return n%2 == 0 }, numbers)
+//	// Result: 3
+func FindLastIndex[T any](fn func(T) bool, slice []T) int {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if fn(slice[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ForEach calls a function for each element of a slice, in order.
+//
+// Example:
+//
+//	ForEach(func(s string) { fmt.Println(s) }, []string{"a", "b"})
+//	// Prints: a, b
+func ForEach[T any](fn func(T), slice []T) {
+	for _, v := range slice {
+		fn(v)
+	}
+}
+
+// ForEachIndexed calls a function for each element of a slice along with its index, in order.
+//
+// Example:
+//
+//	ForEachIndexed(func(s string, i int) { fmt.Printf("%d: %s\n", i, s) }, []string{"a", "b"})
+//	// Prints: 0: a, 1: b
+func ForEachIndexed[T any](fn func(T, int), slice []T) {
+	for i, v := range slice {
+		fn(v, i)
+	}
+}
@@ -0,0 +1,67 @@
+package rslice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapIndexed(t *testing.T) {
+	names := []string{"alice", "bob", "carol"}
+	rows := MapIndexed(func(s string, i int) string { return fmt.Sprintf("%d. %s", i+1, s) }, names)
+	assert.Equal(t, []string{"1. alice", "2. bob", "3. carol"}, rows)
+
+	// Test empty slice
+	assert.Empty(t, MapIndexed(func(s string, i int) int { return i }, []string{}))
+}
+
+func TestFilterIndexed(t *testing.T) {
+	letters := []string{"a", "b", "c", "d", "e"}
+	evenPositions := FilterIndexed(func(_ string, i int) bool { return i%2 == 0 }, letters)
+	assert.Equal(t, []string{"a", "c", "e"}, evenPositions)
+
+	// Test using both value and index
+	numbers := []int{0, 5, 2, 1, 4}
+	matching := FilterIndexed(func(n, i int) bool { return n == i }, numbers)
+	assert.Equal(t, []int{0, 2, 4}, matching)
+}
+
+func TestReduceIndexed(t *testing.T) {
+	numbers := []int{10, 20, 30}
+	weighted := ReduceIndexed(func(acc, n, i int) int { return acc + n*i }, 0, numbers)
+	assert.Equal(t, 80, weighted)
+
+	// Test with empty slice returns initial value
+	assert.Equal(t, 7, ReduceIndexed(func(acc, n, i int) int { return acc + n }, 7, []int{}))
+}
+
+func TestFindIndex(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, 3, FindIndex(func(n int) bool { return n > 3 }, numbers))
+	assert.Equal(t, 0, FindIndex(func(n int) bool { return n > 0 }, numbers))
+	assert.Equal(t, -1, FindIndex(func(n int) bool { return n > 10 }, numbers))
+	assert.Equal(t, -1, FindIndex(func(n int) bool { return true }, []int{}))
+}
+
+func TestFindLastIndex(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, 3, FindLastIndex(func(n int) bool { return n%2 == 0 }, numbers))
+	assert.Equal(t, 4, FindLastIndex(func(n int) bool { return n > 0 }, numbers))
+	assert.Equal(t, -1, FindLastIndex(func(n int) bool { return n > 10 }, numbers))
+	assert.Equal(t, -1, FindLastIndex(func(n int) bool { return true }, []int{}))
+}
+
+func TestForEach(t *testing.T) {
+	var visited []string
+	ForEach(func(s string) { visited = append(visited, s) }, []string{"a", "b", "c"})
+	assert.Equal(t, []string{"a", "b", "c"}, visited)
+}
+
+func TestForEachIndexed(t *testing.T) {
+	var visited []string
+	ForEachIndexed(func(s string, i int) {
+		visited = append(visited, fmt.Sprintf("%d:%s", i, s))
+	}, []string{"a", "b", "c"})
+	assert.Equal(t, []string{"0:a", "1:b", "2:c"}, visited)
+}
@@ -0,0 +1,91 @@
+package rslice
+
+import "slices"
+
+// The functions in this file reuse the memory of their input instead of
+// allocating a new slice, for use on hot paths. They modify the input and
+// return it, possibly shortened; callers should use the returned slice and not
+// rely on the contents of the input afterwards.
+
+// MapInPlace replaces each element of a slice with the result of applying a function to it.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3}
+//	MapInPlace(func(n int) int { return n * 2 }, numbers)
+//	// numbers is now []int{2, 4, 6}
+func MapInPlace[T any](fn func(T) T, slice []T) []T {
+	for i, v := range slice {
+		slice[i] = fn(v)
+	}
+	return slice
+}
+
+// FilterInPlace moves the elements that satisfy the predicate function to the
+// front of the slice, preserving their order, and returns the shortened slice.
+// The elements past the new length are zeroed so they can be garbage collected.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5, 6}
+//	numbers = FilterInPlace(func(n int) bool { return n%2 == 0 }, numbers)
+//	// Result: []int{2, 4, 6}
+func FilterInPlace[T any](fn func(T) bool, slice []T) []T {
+	n := 0
+	for _, v := range slice {
+		if fn(v) {
+			slice[n] = v
+			n++
+		}
+	}
+	clear(slice[n:])
+	return slice[:n]
+}
+
+// ReverseInPlace reverses the order of the elements of a slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3}
+//	ReverseInPlace(numbers)
+//	// numbers is now []int{3, 2, 1}
+func ReverseInPlace[T any](slice []T) []T {
+	slices.Reverse(slice)
+	return slice
+}
+
+// UniqueInPlace removes duplicate elements from a slice, keeping the first
+// occurrence of each, and returns the shortened slice. The elements past the new
+// length are zeroed. It does not allocate: each element is compared against the
+// unique elements kept so far, which takes O(n·k) time for k unique elements.
+// Prefer Unique for long slices with many distinct values.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 2, 3, 1}
+//	numbers = UniqueInPlace(numbers)
+//	// Result: []int{1, 2, 3}
+func UniqueInPlace[T comparable](slice []T) []T {
+	n := 0
+	for _, v := range slice {
+		if !slices.Contains(slice[:n], v) {
+			slice[n] = v
+			n++
+		}
+	}
+	clear(slice[n:])
+	return slice[:n]
+}
+
+// SortInPlace sorts a slice using a comparison function, following the SortBy
+// convention. The sort is stable.
+//
+// Example:
+//
+//	numbers := []int{3, 1, 2}
+//	SortInPlace(func(a, b int) bool { return a < b }, numbers)
+//	// numbers is now []int{1, 2, 3}
+func SortInPlace[T any](fn func(T, T) bool, slice []T) []T {
+	slices.SortStableFunc(slice, compareFunc(fn))
+	return slice
+}
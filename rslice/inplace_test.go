@@ -0,0 +1,77 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapInPlace(t *testing.T) {
+	numbers := []int{1, 2, 3}
+	result := MapInPlace(func(n int) int { return n * 2 }, numbers)
+	assert.Equal(t, []int{2, 4, 6}, numbers)
+	assert.Equal(t, numbers, result)
+}
+
+func TestFilterInPlace(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+	result := FilterInPlace(func(n int) bool { return n%2 == 0 }, numbers)
+	assert.Equal(t, []int{2, 4, 6}, result)
+	assert.Equal(t, []int{2, 4, 6, 0, 0, 0}, numbers, "tail should be zeroed")
+
+	assert.Empty(t, FilterInPlace(func(n int) bool { return true }, []int{}))
+}
+
+func TestReverseInPlace(t *testing.T) {
+	numbers := []int{1, 2, 3, 4}
+	ReverseInPlace(numbers)
+	assert.Equal(t, []int{4, 3, 2, 1}, numbers)
+}
+
+func TestUniqueInPlace(t *testing.T) {
+	numbers := []int{1, 2, 2, 3, 1}
+	result := UniqueInPlace(numbers)
+	assert.Equal(t, []int{1, 2, 3}, result)
+	assert.Equal(t, []int{1, 2, 3, 0, 0}, numbers)
+
+	long := make([]int, 100)
+	for i := range long {
+		long[i] = i % 10
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, UniqueInPlace(long))
+}
+
+func TestSortInPlace(t *testing.T) {
+	numbers := []int{3, 1, 4, 1, 5}
+	SortInPlace(func(a, b int) bool { return a < b }, numbers)
+	assert.Equal(t, []int{1, 1, 3, 4, 5}, numbers)
+}
+
+func TestInPlaceAllocations(t *testing.T) {
+	// Large enough that no function can get away with a small-input fast path
+	numbers := make([]int, 1000)
+	double := func(n int) int { return n * 2 }
+	even := func(n int) bool { return n%2 == 0 }
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"MapInPlace", func() { MapInPlace(double, numbers) }},
+		{"FilterInPlace", func() { FilterInPlace(even, numbers) }},
+		{"ReverseInPlace", func() { ReverseInPlace(numbers) }},
+		{"UniqueInPlace", func() { UniqueInPlace(numbers) }},
+		{"SortInPlace", func() { SortInPlace(less, numbers) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := range numbers {
+				numbers[i] = len(numbers) - i
+			}
+			allocs := testing.AllocsPerRun(100, test.fn)
+			assert.Zero(t, allocs)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package rslice
+
+// The joins in this file correlate two slices by key, like their SQL
+// counterparts. They are hash joins: the right slice is indexed with GroupBy,
+// so each join runs in O(len(left) + len(right) + number of result rows).
+// Results follow the order of the left slice, and for each left element, the
+// order of its matches in the right slice. Where a side may be missing, it is
+// reported as a pointer that is nil when there is no match and otherwise points
+// to a copy of the matched element.
+
+// InnerJoin pairs every left element with every right element that has the same key.
+// Elements without a match on the other side are dropped.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "ann"}, {ID: 2, Name: "bob"}}
+//	orders := []Order{{UserID: 1, Item: "book"}, {UserID: 1, Item: "pen"}}
+//	rows := InnerJoin(
+//		func(u User) int { return u.ID },
+//		func(o Order) int { return o.UserID },
+//		users, orders,
+//	)
+//	// Result: [{ann book} {ann pen}]
+func InnerJoin[L, R any, K comparable](leftKey func(L) K, rightKey func(R) K, left []L, right []R) []struct {
+	Left  L
+	Right R
+} {
+	index := GroupBy(rightKey, right)
+	result := []struct {
+		Left  L
+		Right R
+	}{}
+	for _, l := range left {
+		for _, r := range index[leftKey(l)] {
+			result = append(result, struct {
+				Left  L
+				Right R
+			}{l, r})
+		}
+	}
+	return result
+}
+
+// LeftJoin pairs every left element with every right element that has the same
+// key. Left elements without a match are kept once with a nil Right.
+//
+// Example:
+//
+//	rows := LeftJoin(userID, orderUserID, users, orders)
+//	// Result: [{ann &book} {ann &pen} {bob nil}]
+func LeftJoin[L, R any, K comparable](leftKey func(L) K, rightKey func(R) K, left []L, right []R) []struct {
+	Left  L
+	Right *R
+} {
+	index := GroupBy(rightKey, right)
+	result := make([]struct {
+		Left  L
+		Right *R
+	}, 0, len(left))
+	for _, l := range left {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			result = append(result, struct {
+				Left  L
+				Right *R
+			}{Left: l})
+			continue
+		}
+		for _, r := range matches {
+			result = append(result, struct {
+				Left  L
+				Right *R
+			}{l, &r})
+		}
+	}
+	return result
+}
+
+// FullOuterJoin pairs every left element with every right element that has the
+// same key, keeping unmatched elements from both sides. Unmatched left elements
+// appear in left order with a nil Right, followed by unmatched right elements in
+// right order with a nil Left.
+//
+// Example:
+//
+//	rows := FullOuterJoin(userID, orderUserID, users, orders)
+//	// Result: [{&ann &book} {&ann &pen} {&bob nil} {nil &orphanOrder}]
+func FullOuterJoin[L, R any, K comparable](leftKey func(L) K, rightKey func(R) K, left []L, right []R) []struct {
+	Left  *L
+	Right *R
+} {
+	index := GroupBy(rightKey, right)
+	matched := make(map[K]struct{}, len(index))
+	result := make([]struct {
+		Left  *L
+		Right *R
+	}, 0, max(len(left), len(right)))
+
+	for _, l := range left {
+		key := leftKey(l)
+		matches := index[key]
+		if len(matches) == 0 {
+			result = append(result, struct {
+				Left  *L
+				Right *R
+			}{Left: &l})
+			continue
+		}
+		matched[key] = struct{}{}
+		for _, r := range matches {
+			result = append(result, struct {
+				Left  *L
+				Right *R
+			}{&l, &r})
+		}
+	}
+
+	for _, r := range right {
+		if _, exists := matched[rightKey(r)]; !exists {
+			result = append(result, struct {
+				Left  *L
+				Right *R
+			}{Right: &r})
+		}
+	}
+	return result
+}
+
+// SemiJoin returns the left elements that have at least one right element with
+// the same key. Each left element appears at most once.
+//
+// Example:
+//
+//	buyers := SemiJoin(userID, orderUserID, users, orders)
+//	// Result: []User{ann}
+func SemiJoin[L, R any, K comparable](leftKey func(L) K, rightKey func(R) K, left []L, right []R) []L {
+	keys := keySet(rightKey, right)
+	return Filter(func(l L) bool {
+		_, exists := keys[leftKey(l)]
+		return exists
+	}, left)
+}
+
+// AntiJoin returns the left elements that have no right element with the same key.
+//
+// Example:
+//
+//	inactive := AntiJoin(userID, orderUserID, users, orders)
+//	// Result: []User{bob}
+func AntiJoin[L, R any, K comparable](leftKey func(L) K, rightKey func(R) K, left []L, right []R) []L {
+	keys := keySet(rightKey, right)
+	return Filter(func(l L) bool {
+		_, exists := keys[leftKey(l)]
+		return !exists
+	}, left)
+}
@@ -0,0 +1,102 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	UserID int
+	Item   string
+}
+
+var (
+	joinUsers = []joinUser{{1, "ann"}, {2, "bob"}, {3, "cy"}}
+	// User 3 has two orders, user 2 none, user 9 does not exist
+	joinOrders = []joinOrder{{3, "lamp"}, {1, "book"}, {9, "ghost"}, {3, "desk"}}
+)
+
+func joinUserID(u joinUser) int     { return u.ID }
+func joinOrderUser(o joinOrder) int { return o.UserID }
+
+func TestInnerJoin(t *testing.T) {
+	rows := InnerJoin(joinUserID, joinOrderUser, joinUsers, joinOrders)
+
+	require.Len(t, rows, 3)
+	assert.Equal(t, "ann", rows[0].Left.Name)
+	assert.Equal(t, "book", rows[0].Right.Item)
+	assert.Equal(t, "cy", rows[1].Left.Name)
+	assert.Equal(t, "lamp", rows[1].Right.Item)
+	assert.Equal(t, "cy", rows[2].Left.Name)
+	assert.Equal(t, "desk", rows[2].Right.Item)
+
+	assert.Empty(t, InnerJoin(joinUserID, joinOrderUser, joinUsers, []joinOrder{}))
+}
+
+func TestLeftJoin(t *testing.T) {
+	rows := LeftJoin(joinUserID, joinOrderUser, joinUsers, joinOrders)
+
+	require.Len(t, rows, 4)
+	assert.Equal(t, "ann", rows[0].Left.Name)
+	require.NotNil(t, rows[0].Right)
+	assert.Equal(t, "book", rows[0].Right.Item)
+	assert.Equal(t, "bob", rows[1].Left.Name)
+	assert.Nil(t, rows[1].Right)
+	assert.Equal(t, "lamp", rows[2].Right.Item)
+	assert.Equal(t, "desk", rows[3].Right.Item)
+
+	// Pointers refer to copies, not the input
+	rows[0].Right.Item = "changed"
+	assert.Equal(t, "book", joinOrders[1].Item)
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	rows := FullOuterJoin(joinUserID, joinOrderUser, joinUsers, joinOrders)
+
+	require.Len(t, rows, 5)
+	describe := func(i int) (string, string) {
+		left, right := "", ""
+		if rows[i].Left != nil {
+			left = rows[i].Left.Name
+		}
+		if rows[i].Right != nil {
+			right = rows[i].Right.Item
+		}
+		return left, right
+	}
+
+	expected := [][2]string{
+		{"ann", "book"},
+		{"bob", ""},
+		{"cy", "lamp"},
+		{"cy", "desk"},
+		{"", "ghost"},
+	}
+	for i, e := range expected {
+		left, right := describe(i)
+		assert.Equal(t, e[0], left, "row %d", i)
+		assert.Equal(t, e[1], right, "row %d", i)
+	}
+
+	// Every match of a left element reports the same left value
+	assert.Equal(t, *rows[2].Left, *rows[3].Left)
+}
+
+func TestSemiJoin(t *testing.T) {
+	buyers := SemiJoin(joinUserID, joinOrderUser, joinUsers, joinOrders)
+	assert.Equal(t, []joinUser{{1, "ann"}, {3, "cy"}}, buyers)
+}
+
+func TestAntiJoin(t *testing.T) {
+	inactive := AntiJoin(joinUserID, joinOrderUser, joinUsers, joinOrders)
+	assert.Equal(t, []joinUser{{2, "bob"}}, inactive)
+
+	assert.Equal(t, joinUsers, AntiJoin(joinUserID, joinOrderUser, joinUsers, []joinOrder{}))
+}
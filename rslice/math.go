@@ -0,0 +1,242 @@
+package rslice
+
+import (
+	"math"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum returns the sum of all elements in a slice. The sum of an empty slice is 0.
+//
+// Example:
+//
+//	total := Sum([]int{1, 2, 3, 4})
+//	// Result: 10
+func Sum[T Number](slice []T) T {
+	var result T
+	for _, v := range slice {
+		result += v
+	}
+	return result
+}
+
+// Product returns the product of all elements in a slice. The product of an empty slice is 1.
+//
+// Example:
+//
+//	product := Product([]int{1, 2, 3, 4})
+//	// Result: 24
+func Product[T Number](slice []T) T {
+	var result T = 1
+	for _, v := range slice {
+		result *= v
+	}
+	return result
+}
+
+// Min returns the smallest element in a slice, along with a boolean that is false
+// if the slice is empty.
+//
+// Example:
+//
+//	smallest, ok := Min([]int{3, 1, 2})
+//	// Result: smallest = 1, ok = true
+func Min[T constraints.Ordered](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Min(slice), true
+}
+
+// Max returns the largest element in a slice, along with a boolean that is false
+// if the slice is empty.
+//
+// Example:
+//
+//	largest, ok := Max([]int{3, 1, 2})
+//	// Result: largest = 3, ok = true
+func Max[T constraints.Ordered](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Max(slice), true
+}
+
+// MinBy returns the element with the smallest key, along with a boolean that is
+// false if the slice is empty. The key function is called once per element and
+// the first element wins ties.
+//
+// Example:
+//
+//	words := []string{"banana", "fig", "kiwi"}
+//	shortest, ok := MinBy(func(s string) int { return len(s) }, words)
+//	// Result: shortest = "fig", ok = true
+func MinBy[T any, K constraints.Ordered](fn func(T) K, slice []T) (T, bool) {
+	return extremeBy(fn, func(a, b K) bool { return a < b }, slice)
+}
+
+// MaxBy returns the element with the largest key, along with a boolean that is
+// false if the slice is empty. The key function is called once per element and
+// the first element wins ties.
+//
+// Example:
+//
+//	words := []string{"banana", "fig", "kiwi"}
+//	longest, ok := MaxBy(func(s string) int { return len(s) }, words)
+//	// Result: longest = "banana", ok = true
+func MaxBy[T any, K constraints.Ordered](fn func(T) K, slice []T) (T, bool) {
+	return extremeBy(fn, func(a, b K) bool { return a > b }, slice)
+}
+
+// Mean returns the arithmetic mean of a slice as a float64, along with a boolean
+// that is false if the slice is empty.
+//
+// Example:
+//
+//	mean, ok := Mean([]int{1, 2, 3, 4})
+//	// Result: mean = 2.5, ok = true
+func Mean[T Number](slice []T) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range slice {
+		sum += float64(v)
+	}
+	return sum / float64(len(slice)), true
+}
+
+// Median returns the middle value of a slice as a float64, along with a boolean
+// that is false if the slice is empty. For an even number of elements it is the
+// mean of the two middle values. The input slice is not modified.
+//
+// Example:
+//
+//	median, ok := Median([]int{5, 1, 4, 2})
+//	// Result: median = 3, ok = true
+func Median[T Number](slice []T) (float64, bool) {
+	return Percentile(50, slice)
+}
+
+// Mode returns the most frequent element of a slice, along with a boolean that is
+// false if the slice is empty. When several elements are equally frequent, the one
+// that appears first wins.
+//
+// Example:
+//
+//	mode, ok := Mode([]string{"b", "a", "b", "a", "c"})
+//	// Result: mode = "b", ok = true
+func Mode[T comparable](slice []T) (T, bool) {
+	var result T
+	if len(slice) == 0 {
+		return result, false
+	}
+
+	counts := make(map[T]int, len(slice))
+	best := 0
+	for _, v := range slice {
+		counts[v]++
+	}
+	for _, v := range slice {
+		if counts[v] > best {
+			result, best = v, counts[v]
+		}
+	}
+	return result, true
+}
+
+// Variance returns the population variance of a slice as a float64, along with a
+// boolean that is false if the slice is empty.
+//
+// Example:
+//
+//	variance, ok := Variance([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// Result: variance = 4, ok = true
+func Variance[T Number](slice []T) (float64, bool) {
+	mean, ok := Mean(slice)
+	if !ok {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range slice {
+		d := float64(v) - mean
+		sum += d * d
+	}
+	return sum / float64(len(slice)), true
+}
+
+// StdDev returns the population standard deviation of a slice as a float64, along
+// with a boolean that is false if the slice is empty.
+//
+// Example:
+//
+//	stddev, ok := StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// Result: stddev = 2, ok = true
+func StdDev[T Number](slice []T) (float64, bool) {
+	variance, ok := Variance(slice)
+	if !ok {
+		return 0, false
+	}
+	return math.Sqrt(variance), true
+}
+
+// Percentile returns the p-th percentile (0 to 100) of a slice as a float64,
+// interpolating linearly between the two nearest ranks. Interpolating towards an
+// infinite value gives that infinity. The boolean is false if the slice is empty
+// or p is outside [0, 100]. The input slice is not modified.
+//
+// Example:
+//
+//	p90, ok := Percentile(90, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+//	// Result: p90 = 9.1, ok = true
+func Percentile[T Number](p float64, slice []T) (float64, bool) {
+	if len(slice) == 0 || !(p >= 0 && p <= 100) {
+		return 0, false
+	}
+
+	sorted := make([]float64, len(slice))
+	for i, v := range slice {
+		sorted[i] = float64(v)
+	}
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	frac := rank - float64(lower)
+	low, high := sorted[lower], sorted[upper]
+	switch {
+	case frac == 0 || low == high:
+		return low, true
+	case math.IsInf(low, 0) || math.IsInf(high, 0):
+		// Weighting each side keeps an infinite bound infinite instead of Inf-Inf = NaN
+		return low*(1-frac) + high*frac, true
+	default:
+		return low + (high-low)*frac, true
+	}
+}
+
+// extremeBy returns the element whose key wins against every other key according
+// to better, keeping the earliest element on ties.
+func extremeBy[T any, K constraints.Ordered](fn func(T) K, better func(K, K) bool, slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	result, bestKey := slice[0], fn(slice[0])
+	for _, v := range slice[1:] {
+		if key := fn(v); better(key, bestKey) {
+			result, bestKey = v, key
+		}
+	}
+	return result, true
+}
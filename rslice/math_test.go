@@ -0,0 +1,161 @@
+package rslice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.InDelta(t, 4.0, Sum([]float64{1.5, 2.5}), 1e-9)
+	assert.Equal(t, 0, Sum([]int{}))
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 24, Product([]int{1, 2, 3, 4}))
+	assert.Equal(t, 1, Product([]int{}))
+}
+
+func TestMinMax(t *testing.T) {
+	smallest, ok := Min([]int{3, 1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, 1, smallest)
+
+	largest, ok := Max([]string{"b", "c", "a"})
+	assert.True(t, ok)
+	assert.Equal(t, "c", largest)
+
+	_, ok = Min([]int{})
+	assert.False(t, ok)
+	_, ok = Max([]int{})
+	assert.False(t, ok)
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	words := []string{"banana", "fig", "kiwi", "pea", "cherry"}
+	length := func(s string) int { return len(s) }
+
+	shortest, ok := MinBy(length, words)
+	assert.True(t, ok)
+	assert.Equal(t, "fig", shortest)
+
+	longest, ok := MaxBy(length, words)
+	assert.True(t, ok)
+	assert.Equal(t, "banana", longest)
+
+	_, ok = MinBy(length, []string{})
+	assert.False(t, ok)
+	_, ok = MaxBy(length, []string{})
+	assert.False(t, ok)
+}
+
+func TestMean(t *testing.T) {
+	mean, ok := Mean([]int{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5, mean, 1e-9)
+
+	_, ok = Mean([]int{})
+	assert.False(t, ok)
+}
+
+func TestMedian(t *testing.T) {
+	median, ok := Median([]int{5, 1, 4, 2})
+	assert.True(t, ok)
+	assert.InDelta(t, 3.0, median, 1e-9)
+
+	median, ok = Median([]int{5, 1, 3})
+	assert.True(t, ok)
+	assert.InDelta(t, 3.0, median, 1e-9)
+
+	// Input must not be sorted in place
+	numbers := []int{3, 1, 2}
+	Median(numbers)
+	assert.Equal(t, []int{3, 1, 2}, numbers)
+
+	_, ok = Median([]float64{})
+	assert.False(t, ok)
+
+	// Infinite values are returned, not turned into NaN
+	median, ok = Median([]float64{math.Inf(1), math.Inf(1)})
+	assert.True(t, ok)
+	assert.Equal(t, math.Inf(1), median)
+
+	median, _ = Median([]float64{math.Inf(-1), math.Inf(-1), 3})
+	assert.Equal(t, math.Inf(-1), median)
+}
+
+func TestMode(t *testing.T) {
+	mode, ok := Mode([]string{"b", "a", "b", "a", "c"})
+	assert.True(t, ok)
+	assert.Equal(t, "b", mode)
+
+	mode, ok = Mode([]string{"a", "b", "b"})
+	assert.True(t, ok)
+	assert.Equal(t, "b", mode)
+
+	_, ok = Mode([]int{})
+	assert.False(t, ok)
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	numbers := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	variance, ok := Variance(numbers)
+	assert.True(t, ok)
+	assert.InDelta(t, 4.0, variance, 1e-9)
+
+	stddev, ok := StdDev(numbers)
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, stddev, 1e-9)
+
+	_, ok = Variance([]int{})
+	assert.False(t, ok)
+	_, ok = StdDev([]int{})
+	assert.False(t, ok)
+}
+
+func TestPercentile(t *testing.T) {
+	numbers := []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+
+	tests := []struct {
+		p        float64
+		expected float64
+	}{
+		{0, 1},
+		{50, 5.5},
+		{90, 9.1},
+		{100, 10},
+	}
+
+	for _, test := range tests {
+		result, ok := Percentile(test.p, numbers)
+		assert.True(t, ok)
+		assert.InDelta(t, test.expected, result, 1e-9, "p=%v", test.p)
+	}
+
+	// Infinite values at or next to the rank are kept
+	infinite := []float64{math.Inf(-1), 1, math.Inf(1)}
+	for _, test := range []struct {
+		p        float64
+		expected float64
+	}{
+		{0, math.Inf(-1)},
+		{25, math.Inf(-1)},
+		{50, 1},
+		{75, math.Inf(1)},
+		{100, math.Inf(1)},
+	} {
+		result, ok := Percentile(test.p, infinite)
+		assert.True(t, ok)
+		assert.Equal(t, test.expected, result, "p=%v", test.p)
+	}
+
+	_, ok := Percentile(-1, numbers)
+	assert.False(t, ok)
+	_, ok = Percentile(101, numbers)
+	assert.False(t, ok)
+	_, ok = Percentile(50, []int{})
+	assert.False(t, ok)
+}
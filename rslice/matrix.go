@@ -0,0 +1,95 @@
+package rslice
+
+// The functions in this file treat a [][]T as a grid of rows. Rows may have
+// different lengths; cells that a short row lacks are skipped rather than
+// filled with zero values. Results are newly allocated and never share rows
+// with the input.
+
+// Transpose turns the rows of a matrix into columns. Result row j holds the
+// j-th element of every input row that is long enough, in row order, so with
+// ragged input the shorter rows simply contribute nothing to later columns.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2, 3}, {4, 5, 6}}
+//	transposed := Transpose(matrix)
+//	// Result: [][]int{{1, 4}, {2, 5}, {3, 6}}
+//
+//	ragged := Transpose([][]int{{1, 2}, {3}, {4, 5, 6}})
+//	// Result: [][]int{{1, 3, 4}, {2, 5}, {6}}
+func Transpose[T any](matrix [][]T) [][]T {
+	width := 0
+	for _, row := range matrix {
+		width = max(width, len(row))
+	}
+
+	result := make([][]T, width)
+	for j := range result {
+		result[j] = Column(j, matrix)
+	}
+	return result
+}
+
+// MapMatrix applies a function to every cell of a matrix and returns a new
+// matrix of the same shape with the results.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2}, {3}}
+//	labels := MapMatrix(func(n int) string { return strconv.Itoa(n) }, matrix)
+//	// Result: [][]string{{"1", "2"}, {"3"}}
+func MapMatrix[T, R any](fn func(T) R, matrix [][]T) [][]R {
+	result := make([][]R, len(matrix))
+	for i, row := range matrix {
+		result[i] = Map(fn, row)
+	}
+	return result
+}
+
+// Row returns a copy of row i of a matrix. If i is out of range the result is empty.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2}, {3, 4}}
+//	second := Row(1, matrix)
+//	// Result: []int{3, 4}
+func Row[T any](i int, matrix [][]T) []T {
+	if i < 0 || i >= len(matrix) {
+		return []T{}
+	}
+	return append([]T{}, matrix[i]...)
+}
+
+// Column returns column j of a matrix, taking the j-th element of every row
+// that is long enough. If no row has a j-th element the result is empty.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2}, {3}, {4, 5}}
+//	second := Column(1, matrix)
+//	// Result: []int{2, 5}
+func Column[T any](j int, matrix [][]T) []T {
+	result := make([]T, 0, len(matrix))
+	if j < 0 {
+		return result
+	}
+	for _, row := range matrix {
+		if j < len(row) {
+			result = append(result, row[j])
+		}
+	}
+	return result
+}
+
+// Rotate90 rotates a matrix a quarter turn clockwise, so the first column read
+// bottom to top becomes the first row. Ragged input follows Transpose: cells
+// missing from short rows are skipped.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2, 3}, {4, 5, 6}}
+//	rotated := Rotate90(matrix)
+//	// Result: [][]int{{4, 1}, {5, 2}, {6, 3}}
+func Rotate90[T any](matrix [][]T) [][]T {
+	return Transpose(Reverse(matrix))
+}
@@ -0,0 +1,73 @@
+package rslice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranspose(t *testing.T) {
+	matrix := [][]int{{1, 2, 3}, {4, 5, 6}}
+	assert.Equal(t, [][]int{{1, 4}, {2, 5}, {3, 6}}, Transpose(matrix))
+	assert.Equal(t, matrix, Transpose(Transpose(matrix)))
+
+	// Ragged rows are skipped where they have no cell
+	assert.Equal(t, [][]int{{1, 3, 4}, {2, 5}, {6}}, Transpose([][]int{{1, 2}, {3}, {4, 5, 6}}))
+
+	assert.Equal(t, [][]int{}, Transpose([][]int{}))
+	assert.Equal(t, [][]int{}, Transpose([][]int{{}, {}}))
+
+	// Result does not share memory with the input
+	transposed := Transpose(matrix)
+	transposed[0][0] = 9
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}}, matrix)
+}
+
+func TestMapMatrix(t *testing.T) {
+	labels := MapMatrix(strconv.Itoa, [][]int{{1, 2}, {3}})
+	assert.Equal(t, [][]string{{"1", "2"}, {"3"}}, labels)
+	assert.Equal(t, [][]string{}, MapMatrix(strconv.Itoa, [][]int{}))
+
+	// Result does not share memory with the input, even for the identity function
+	matrix := [][]int{{1, 2}, {3}}
+	mapped := MapMatrix(func(n int) int { return n }, matrix)
+	mapped[0][0] = 9
+	assert.Equal(t, [][]int{{1, 2}, {3}}, matrix)
+}
+
+func TestRow(t *testing.T) {
+	matrix := [][]int{{1, 2}, {3, 4}}
+
+	row := Row(1, matrix)
+	assert.Equal(t, []int{3, 4}, row)
+	row[0] = 99
+	assert.Equal(t, 3, matrix[1][0])
+
+	assert.Equal(t, []int{}, Row(2, matrix))
+	assert.Equal(t, []int{}, Row(-1, matrix))
+}
+
+func TestColumn(t *testing.T) {
+	matrix := [][]int{{1, 2}, {3}, {4, 5}}
+
+	assert.Equal(t, []int{1, 3, 4}, Column(0, matrix))
+	assert.Equal(t, []int{2, 5}, Column(1, matrix))
+	assert.Equal(t, []int{}, Column(2, matrix))
+	assert.Equal(t, []int{}, Column(-1, matrix))
+}
+
+func TestRotate90(t *testing.T) {
+	matrix := [][]int{{1, 2, 3}, {4, 5, 6}}
+	assert.Equal(t, [][]int{{4, 1}, {5, 2}, {6, 3}}, Rotate90(matrix))
+
+	// Four quarter turns return the original
+	assert.Equal(t, matrix, Rotate90(Rotate90(Rotate90(Rotate90(matrix)))))
+
+	assert.Equal(t, [][]string{{"c", "a"}, {"d", "b"}}, Rotate90([][]string{{"a", "b"}, {"c", "d"}}))
+
+	// Result does not share memory with the input
+	rotated := Rotate90(matrix)
+	rotated[0][0] = 9
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}}, matrix)
+}
@@ -0,0 +1,189 @@
+package rslice
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// Every function in this file takes an explicit *rand.Rand so results can be made
+// reproducible by seeding it, for example with rand.New(rand.NewPCG(1, 2)).
+// Passing nil uses the automatically seeded top-level source of math/rand/v2.
+
+// Shuffle returns a new slice with the elements in random order, using a
+// Fisher-Yates shuffle. The input slice is not modified.
+//
+// Example:
+//
+//	rng := rand.New(rand.NewPCG(1, 2))
+//	shuffled := Shuffle(rng, []int{1, 2, 3, 4, 5})
+//	// Result: the same elements in a random, seed-dependent order
+func Shuffle[T any](rng *rand.Rand, slice []T) []T {
+	result := append([]T{}, slice...)
+	for i := len(result) - 1; i > 0; i-- {
+		j := randIntN(rng, i+1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Sample returns a random element of a slice, along with a boolean that is false
+// if the slice is empty.
+//
+// Example:
+//
+//	winner, ok := Sample(rng, []string{"a", "b", "c"})
+func Sample[T any](rng *rand.Rand, slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slice[randIntN(rng, len(slice))], true
+}
+
+// SampleN returns n elements of a slice chosen at random without replacement,
+// so no position is picked twice. The result is in random order. If n is greater than the length of the slice,
+// every element is returned; if n is less than or equal to zero, the result is empty.
+//
+// Example:
+//
+//	canaries := SampleN(rng, 2, hosts)
+func SampleN[T any](rng *rand.Rand, n int, slice []T) []T {
+	n = max(0, min(n, len(slice)))
+	pool := append([]T{}, slice...)
+	// Partial Fisher-Yates: only the first n positions need to be settled
+	for i := 0; i < n; i++ {
+		j := i + randIntN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
+
+// SampleNWithReplacement returns n elements of a slice chosen independently at
+// random, so the same element may appear more than once. If the slice is empty
+// or n is less than or equal to zero, the result is empty.
+//
+// Example:
+//
+//	draws := SampleNWithReplacement(rng, 10, []string{"heads", "tails"})
+func SampleNWithReplacement[T any](rng *rand.Rand, n int, slice []T) []T {
+	if n <= 0 || len(slice) == 0 {
+		return []T{}
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = slice[randIntN(rng, len(slice))]
+	}
+	return result
+}
+
+// WeightedSample returns a random element of a slice where each element is chosen
+// with probability proportional to the weight at the same index. The boolean is
+// false if the lengths differ, a weight is negative, or all weights are zero.
+//
+// Example:
+//
+//	bucket, ok := WeightedSample(rng, []float64{90, 10}, []string{"control", "variant"})
+//	// Result: "control" about 90% of the time
+func WeightedSample[T any](rng *rand.Rand, weights []float64, slice []T) (T, bool) {
+	var zero T
+	if len(weights) != len(slice) {
+		return zero, false
+	}
+
+	var total float64
+	for _, w := range weights {
+		if w < 0 {
+			return zero, false
+		}
+		total += w
+	}
+	if total <= 0 {
+		return zero, false
+	}
+
+	target := randFloat64(rng) * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return slice[i], true
+		}
+		target -= w
+	}
+	// Floating-point rounding can leave target just above the final weight
+	return slice[last], true
+}
+
+// ReservoirSample returns k elements chosen uniformly at random from a sequence
+// of unknown length, holding at most k elements in memory (Algorithm R).
+// If the sequence yields fewer than k elements, all of them are returned.
+//
+// Example:
+//
+//	sample := ReservoirSample(rng, 100, slices.Values(events))
+func ReservoirSample[T any](rng *rand.Rand, k int, seq iter.Seq[T]) []T {
+	reservoir := NewReservoir[T](rng, k)
+	for v := range seq {
+		reservoir.Add(v)
+	}
+	return reservoir.Sample()
+}
+
+// Reservoir keeps a uniform random sample of at most k elements from a stream
+// that is fed one element at a time. Use it when the elements are pushed from a
+// channel or callback rather than pulled from an iter.Seq.
+// A Reservoir is not safe for concurrent use.
+type Reservoir[T any] struct {
+	rng   *rand.Rand
+	k     int
+	seen  int
+	items []T
+}
+
+// NewReservoir creates a Reservoir that keeps at most k elements.
+// A k less than or equal to zero keeps nothing.
+func NewReservoir[T any](rng *rand.Rand, k int) *Reservoir[T] {
+	k = max(0, k)
+	return &Reservoir[T]{rng: rng, k: k, items: make([]T, 0, k)}
+}
+
+// Add offers an element to the reservoir.
+func (r *Reservoir[T]) Add(v T) {
+	r.seen++
+	if len(r.items) < r.k {
+		r.items = append(r.items, v)
+		return
+	}
+	if j := randIntN(r.rng, r.seen); j < r.k {
+		r.items[j] = v
+	}
+}
+
+// Seen returns the number of elements offered to the reservoir so far.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Sample returns a copy of the elements currently held by the reservoir.
+func (r *Reservoir[T]) Sample() []T {
+	return append([]T{}, r.items...)
+}
+
+// randIntN returns a random int in [0, n) from rng, or from the top-level source if rng is nil.
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+// randFloat64 returns a random float64 in [0, 1) from rng, or from the top-level source if rng is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
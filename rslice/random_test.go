@@ -0,0 +1,120 @@
+package rslice
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffle(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	shuffled := Shuffle(newTestRand(), numbers)
+	assert.ElementsMatch(t, numbers, shuffled)
+	assert.NotEqual(t, numbers, shuffled)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, numbers)
+
+	// Same seed, same order
+	assert.Equal(t, shuffled, Shuffle(newTestRand(), numbers))
+
+	// Nil source still works
+	assert.ElementsMatch(t, numbers, Shuffle(nil, numbers))
+	assert.Empty(t, Shuffle(newTestRand(), []int{}))
+}
+
+func TestSample(t *testing.T) {
+	letters := []string{"a", "b", "c"}
+
+	v, ok := Sample(newTestRand(), letters)
+	assert.True(t, ok)
+	assert.Contains(t, letters, v)
+
+	_, ok = Sample(newTestRand(), []string{})
+	assert.False(t, ok)
+}
+
+func TestSampleN(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	sample := SampleN(newTestRand(), 4, numbers)
+	assert.Len(t, sample, 4)
+	assert.Len(t, Unique(sample), 4, "sampling without replacement must not repeat positions")
+	for _, v := range sample {
+		assert.Contains(t, numbers, v)
+	}
+	assert.Equal(t, sample, SampleN(newTestRand(), 4, numbers))
+
+	assert.ElementsMatch(t, numbers, SampleN(newTestRand(), 20, numbers))
+	assert.Empty(t, SampleN(newTestRand(), 0, numbers))
+	assert.Empty(t, SampleN(newTestRand(), -1, numbers))
+}
+
+func TestSampleNWithReplacement(t *testing.T) {
+	coins := []string{"heads", "tails"}
+
+	draws := SampleNWithReplacement(newTestRand(), 20, coins)
+	assert.Len(t, draws, 20)
+	assert.Subset(t, coins, draws)
+	assert.Len(t, Unique(draws), 2)
+
+	assert.Empty(t, SampleNWithReplacement(newTestRand(), 3, []string{}))
+	assert.Empty(t, SampleNWithReplacement(newTestRand(), 0, coins))
+}
+
+func TestWeightedSample(t *testing.T) {
+	rng := newTestRand()
+	buckets := []string{"control", "variant", "never"}
+	weights := []float64{90, 10, 0}
+
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		v, ok := WeightedSample(rng, weights, buckets)
+		assert.True(t, ok)
+		counts[v]++
+	}
+	assert.Zero(t, counts["never"])
+	assert.InDelta(t, 9000, counts["control"], 300)
+
+	_, ok := WeightedSample(rng, []float64{1}, buckets)
+	assert.False(t, ok)
+	_, ok = WeightedSample(rng, []float64{1, -1, 1}, buckets)
+	assert.False(t, ok)
+	_, ok = WeightedSample(rng, []float64{0, 0, 0}, buckets)
+	assert.False(t, ok)
+}
+
+func TestReservoirSample(t *testing.T) {
+	numbers := make([]int, 1000)
+	for i := range numbers {
+		numbers[i] = i
+	}
+
+	sample := ReservoirSample(newTestRand(), 10, slices.Values(numbers))
+	assert.Len(t, sample, 10)
+	assert.Len(t, Unique(sample), 10)
+	assert.Equal(t, sample, ReservoirSample(newTestRand(), 10, slices.Values(numbers)))
+
+	assert.Equal(t, []int{1, 2}, ReservoirSample(newTestRand(), 5, slices.Values([]int{1, 2})))
+	assert.Empty(t, ReservoirSample(newTestRand(), 0, slices.Values(numbers)))
+}
+
+func TestReservoir(t *testing.T) {
+	reservoir := NewReservoir[int](newTestRand(), 3)
+	for i := 0; i < 100; i++ {
+		reservoir.Add(i)
+	}
+
+	assert.Equal(t, 100, reservoir.Seen())
+	sample := reservoir.Sample()
+	assert.Len(t, sample, 3)
+
+	// Sample returns a copy
+	sample[0] = -1
+	assert.NotContains(t, reservoir.Sample(), -1)
+}
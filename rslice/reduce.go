@@ -0,0 +1,61 @@
+package rslice
+
+// Scan is like Reduce but returns every intermediate accumulator, starting with
+// the initial value, so the result has one more element than the input.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4}
+//	totals := Scan(func(acc, n int) int { return acc + n }, 0, numbers)
+//	// Result: []int{0, 1, 3, 6, 10}
+func Scan[T, R any](fn func(R, T) R, initial R, slice []T) []R {
+	result := make([]R, 0, len(slice)+1)
+	acc := initial
+	result = append(result, acc)
+	for _, v := range slice {
+		acc = fn(acc, v)
+		result = append(result, acc)
+	}
+	return result
+}
+
+// ReduceRight applies a function to each element of a slice from right to left,
+// accumulating the result.
+//
+// Example:
+//
+//	letters := []string{"a", "b", "c"}
+//	reversed := ReduceRight(func(acc, s string) string { return acc + s }, "", letters)
+//	// Result: "cba"
+func ReduceRight[T, R any](fn func(R, T) R, initial R, slice []T) R {
+	result := initial
+	for i := len(slice) - 1; i >= 0; i-- {
+		result = fn(result, slice[i])
+	}
+	return result
+}
+
+// ReduceWhile applies a function to each element of a slice, accumulating the
+// result, for as long as the predicate holds. The predicate is checked with the
+// current accumulator and the next element before that element is folded in;
+// the first time it returns false, the accumulator is returned as is.
+//
+// Example:
+//
+//	costs := []int{30, 40, 50, 10}
+//	spent := ReduceWhile(
+//		func(acc, n int) bool { return acc+n <= 100 },
+//		func(acc, n int) int { return acc + n },
+//		0, costs,
+//	)
+//	// Result: 70
+func ReduceWhile[T, R any](pred func(R, T) bool, fn func(R, T) R, initial R, slice []T) R {
+	result := initial
+	for _, v := range slice {
+		if !pred(result, v) {
+			break
+		}
+		result = fn(result, v)
+	}
+	return result
+}
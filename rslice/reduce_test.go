@@ -0,0 +1,47 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan(t *testing.T) {
+	add := func(acc, n int) int { return acc + n }
+
+	assert.Equal(t, []int{0, 1, 3, 6, 10}, Scan(add, 0, []int{1, 2, 3, 4}))
+	assert.Equal(t, []int{5}, Scan(add, 5, []int{}))
+
+	// Accumulator of a different type
+	lengths := Scan(func(acc int, s string) int { return acc + len(s) }, 0, []string{"go", "is", "fun"})
+	assert.Equal(t, []int{0, 2, 4, 7}, lengths)
+}
+
+func TestReduceRight(t *testing.T) {
+	concat := func(acc, s string) string { return acc + s }
+
+	assert.Equal(t, "cba", ReduceRight(concat, "", []string{"a", "b", "c"}))
+	assert.Equal(t, "x", ReduceRight(concat, "x", []string{}))
+
+	// Same result as Reduce over the reversed slice
+	numbers := []int{1, 2, 3}
+	subtract := func(acc, n int) int { return n - acc }
+	assert.Equal(t, Reduce(subtract, 0, Reverse(numbers)), ReduceRight(subtract, 0, numbers))
+}
+
+func TestReduceWhile(t *testing.T) {
+	add := func(acc, n int) int { return acc + n }
+	withinBudget := func(acc, n int) bool { return acc+n <= 100 }
+
+	assert.Equal(t, 70, ReduceWhile(withinBudget, add, 0, []int{30, 40, 50, 10}))
+	assert.Equal(t, 100, ReduceWhile(withinBudget, add, 0, []int{50, 50, 1}))
+	assert.Equal(t, 0, ReduceWhile(withinBudget, add, 0, []int{200, 1}))
+	assert.Equal(t, 6, ReduceWhile(withinBudget, add, 0, []int{1, 2, 3}))
+
+	calls := 0
+	ReduceWhile(func(acc, n int) bool {
+		calls++
+		return n > 0
+	}, add, 0, []int{1, -1, 2, 3})
+	assert.Equal(t, 2, calls, "predicate should not be called after it fails")
+}
@@ -0,0 +1,149 @@
+package rslice
+
+// The set operations in this file treat slices as ordered sets: results never
+// contain duplicates and keep elements in the order they were first seen,
+// scanning the first slice before the second. Each operation runs in
+// O(len(a) + len(b)) time.
+
+// Union returns the unique elements that appear in either slice.
+//
+// Example:
+//
+//	a := []int{1, 2, 2, 3}
+//	b := []int{3, 4, 1}
+//	union := Union(a, b)
+//	// Result: []int{1, 2, 3, 4}
+func Union[T comparable](a, b []T) []T {
+	return Unique(append(append(make([]T, 0, len(a)+len(b)), a...), b...))
+}
+
+// UnionBy returns the elements that appear in either slice, comparing elements
+// by the key returned from the key function. When several elements share a key,
+// the first one seen is kept.
+//
+// Example:
+//
+//	type Tag struct{ ID int; Aliases []string }
+//	a := []Tag{{ID: 1}, {ID: 2}}
+//	b := []Tag{{ID: 2}, {ID: 3}}
+//	union := UnionBy(func(t Tag) int { return t.ID }, a, b)
+//	// Result: []Tag{{ID: 1}, {ID: 2}, {ID: 3}}
+func UnionBy[T any, K comparable](fn func(T) K, a, b []T) []T {
+	seen := make(map[K]struct{}, len(a)+len(b))
+	result := make([]T, 0, len(a)+len(b))
+	for _, slice := range [][]T{a, b} {
+		for _, v := range slice {
+			key := fn(v)
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// Intersection returns the unique elements of a that also appear in b.
+//
+// Example:
+//
+//	a := []int{3, 1, 2, 1}
+//	b := []int{1, 3, 5}
+//	common := Intersection(a, b)
+//	// Result: []int{3, 1}
+func Intersection[T comparable](a, b []T) []T {
+	other := ToSet(b)
+	return Unique(Filter(func(v T) bool {
+		_, exists := other[v]
+		return exists
+	}, a))
+}
+
+// IntersectionBy returns the elements of a whose key also appears among the keys of b.
+// When several elements of a share a key, the first one seen is kept.
+func IntersectionBy[T any, K comparable](fn func(T) K, a, b []T) []T {
+	other := keySet(fn, b)
+	seen := make(map[K]struct{}, len(a))
+	result := make([]T, 0, len(a))
+	for _, v := range a {
+		key := fn(v)
+		if _, exists := other[key]; !exists {
+			continue
+		}
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Difference returns the unique elements of a that don't appear in b.
+//
+// Example:
+//
+//	a := []int{1, 2, 3, 2, 4}
+//	b := []int{2, 5}
+//	diff := Difference(a, b)
+//	// Result: []int{1, 3, 4}
+func Difference[T comparable](a, b []T) []T {
+	other := ToSet(b)
+	return Unique(Filter(func(v T) bool {
+		_, exists := other[v]
+		return !exists
+	}, a))
+}
+
+// DifferenceBy returns the elements of a whose key doesn't appear among the keys of b.
+// When several elements of a share a key, the first one seen is kept.
+func DifferenceBy[T any, K comparable](fn func(T) K, a, b []T) []T {
+	return differenceBy(fn, a, keySet(fn, b), make(map[K]struct{}, len(a)), make([]T, 0, len(a)))
+}
+
+// SymmetricDifference returns the unique elements that appear in exactly one of
+// the two slices: first those only in a, then those only in b.
+//
+// Example:
+//
+//	a := []int{1, 2, 3}
+//	b := []int{3, 4, 1, 5}
+//	diff := SymmetricDifference(a, b)
+//	// Result: []int{2, 4, 5}
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return append(Difference(a, b), Difference(b, a)...)
+}
+
+// SymmetricDifferenceBy returns the elements whose key appears in exactly one of
+// the two slices: first those only in a, then those only in b.
+// When several elements share a key, the first one seen is kept.
+func SymmetricDifferenceBy[T any, K comparable](fn func(T) K, a, b []T) []T {
+	seen := make(map[K]struct{}, len(a)+len(b))
+	result := make([]T, 0, len(a)+len(b))
+	result = differenceBy(fn, a, keySet(fn, b), seen, result)
+	return differenceBy(fn, b, keySet(fn, a), seen, result)
+}
+
+// keySet returns the set of keys produced by fn for the elements of slice.
+func keySet[T any, K comparable](fn func(T) K, slice []T) map[K]struct{} {
+	result := make(map[K]struct{}, len(slice))
+	for _, v := range slice {
+		result[fn(v)] = struct{}{}
+	}
+	return result
+}
+
+// differenceBy appends to result the elements of slice whose key is neither in
+// exclude nor already in seen, recording each appended key in seen.
+func differenceBy[T any, K comparable](fn func(T) K, slice []T, exclude, seen map[K]struct{}, result []T) []T {
+	for _, v := range slice {
+		key := fn(v)
+		if _, exists := exclude[key]; exists {
+			continue
+		}
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type setItem struct {
+	ID   int
+	Tags []string
+}
+
+func setItemID(s setItem) int { return s.ID }
+
+func setItemIDs(items []setItem) []int { return Map(setItemID, items) }
+
+func TestUnion(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, Union([]int{1, 2, 2, 3}, []int{3, 4, 1}))
+	assert.Equal(t, []int{3, 1}, Union([]int{}, []int{3, 1, 3}))
+	assert.Equal(t, []int{}, Union([]int{}, []int{}))
+}
+
+func TestUnionBy(t *testing.T) {
+	a := []setItem{{ID: 1, Tags: []string{"a"}}, {ID: 2}}
+	b := []setItem{{ID: 2, Tags: []string{"b"}}, {ID: 3}}
+	union := UnionBy(setItemID, a, b)
+
+	assert.Equal(t, []int{1, 2, 3}, setItemIDs(union))
+	assert.Nil(t, union[1].Tags, "first occurrence should be kept")
+}
+
+func TestIntersection(t *testing.T) {
+	assert.Equal(t, []int{3, 1}, Intersection([]int{3, 1, 2, 1}, []int{1, 3, 5}))
+	assert.Equal(t, []int{}, Intersection([]int{1, 2}, []int{3}))
+}
+
+func TestIntersectionBy(t *testing.T) {
+	a := []setItem{{ID: 3}, {ID: 1}, {ID: 2}, {ID: 3}}
+	b := []setItem{{ID: 1}, {ID: 3}, {ID: 5}}
+	assert.Equal(t, []int{3, 1}, setItemIDs(IntersectionBy(setItemID, a, b)))
+}
+
+func TestDifference(t *testing.T) {
+	assert.Equal(t, []int{1, 3, 4}, Difference([]int{1, 2, 3, 2, 4}, []int{2, 5}))
+	assert.Equal(t, []int{}, Difference([]int{1, 2}, []int{1, 2}))
+	assert.Equal(t, []int{1, 2}, Difference([]int{1, 2}, []int{}))
+}
+
+func TestDifferenceBy(t *testing.T) {
+	a := []setItem{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 1}}
+	b := []setItem{{ID: 2}}
+	assert.Equal(t, []int{1, 3}, setItemIDs(DifferenceBy(setItemID, a, b)))
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	assert.Equal(t, []int{2, 4, 5}, SymmetricDifference([]int{1, 2, 3}, []int{3, 4, 1, 5}))
+	assert.Equal(t, []int{}, SymmetricDifference([]int{1}, []int{1}))
+}
+
+func TestSymmetricDifferenceBy(t *testing.T) {
+	a := []setItem{{ID: 1}, {ID: 2}, {ID: 2}, {ID: 3}}
+	b := []setItem{{ID: 3}, {ID: 4}, {ID: 1}, {ID: 5}, {ID: 4}}
+	assert.Equal(t, []int{2, 4, 5}, setItemIDs(SymmetricDifferenceBy(setItemID, a, b)))
+}
@@ -1,5 +1,10 @@
 package rslice
 
+import (
+	"cmp"
+	"slices"
+)
+
 // Map applies a function to each element of a slice and returns a new slice with the results.
 //
 // Example:
@@ -234,6 +239,14 @@ func GroupBy[T any, K comparable](fn func(T) K, slice []T) map[K][]T {
 }
 
 // SortBy sorts a slice using a comparison function.
+// The comparison function reports whether a should come before b. Both strict (<)
+// and non-strict (<=) comparisons are accepted. The sort makes O(n log n)
+// comparisons and the input slice is not modified.
+//
+// Elements that compare equal come out in the same order as with the original
+// bubble sort implementation: input order when fn is non-strict, and the order
+// produced by swapping equal neighbours when fn is strict. Use SortStableBy to
+// always keep equal elements in input order.
 //
 // Example:
 //
@@ -241,21 +254,169 @@ func GroupBy[T any, K comparable](fn func(T) K, slice []T) map[K][]T {
 //	sorted := SortBy(func(a, b int) bool { return a < b }, numbers)
 //	// Result: []int{1, 1, 2, 3, 4, 5, 6, 9}
 func SortBy[T any](fn func(T, T) bool, slice []T) []T {
-	result := make([]T, len(slice))
-	copy(result, slice)
+	compare := compareFunc(fn)
+
+	order := make([]int, len(slice))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return compare(slice[a], slice[b])
+	})
+
+	// Number the groups of equal elements in ascending order
+	group := make([]int, len(slice))
+	groups := 0
+	for k, i := range order {
+		if k > 0 && compare(slice[order[k-1]], slice[i]) != 0 {
+			groups++
+		}
+		group[i] = groups
+	}
+	groups++
+
+	// Count, for every element, the larger elements that precede it in the input
+	greaterBefore := make([]int, len(slice))
+	seen := make([]int, groups+1) // Fenwick tree over group numbers
+	for i, g := range group {
+		notGreater := 0
+		for j := g + 1; j > 0; j -= j & -j {
+			notGreater += seen[j]
+		}
+		greaterBefore[i] = i - notGreater
+		for j := g + 1; j <= groups; j += j & -j {
+			seen[j]++
+		}
+	}
 
-	// Simple bubble sort for demonstration
-	// In practice, you might want to use a more efficient sorting algorithm
-	for i := 0; i < len(result)-1; i++ {
-		for j := 0; j < len(result)-i-1; j++ {
-			if !fn(result[j], result[j+1]) {
-				result[j], result[j+1] = result[j+1], result[j]
+	result := make([]T, 0, len(slice))
+	ring := make([]int, len(slice))
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && group[order[end]] == group[order[start]] {
+			end++
+		}
+		members := order[start:end]
+		if len(members) > 1 && !fn(slice[members[0]], slice[members[1]]) {
+			bubbleTieOrder(members, greaterBefore, len(slice)-end, ring[:len(members)])
+		}
+		for _, i := range members {
+			result = append(result, slice[i])
+		}
+		start = end
+	}
+	return result
+}
+
+// bubbleTieOrder reorders the input positions of a group of equal elements into
+// the order a bubble sort that swaps equal neighbours leaves them in. members
+// must be in input order, greaterBefore holds the number of larger elements
+// preceding each input position and greater is the total number of larger
+// elements. ring is scratch space of the same length as members.
+//
+// Each bubble pass removes the first larger element from the unsorted part; if
+// one of the group comes before every larger element, the first of them is
+// carried past the rest of that leading run. Once no larger elements remain,
+// the passes reverse the group.
+func bubbleTieOrder(members, greaterBefore []int, greater int, ring []int) {
+	head, size := 0, 0
+	rotate := func(passes int) {
+		if size < 2 {
+			return
+		}
+		if passes %= size; passes <= size/2 {
+			for range passes {
+				ring[(head+size)%len(ring)] = ring[head]
+				head = (head + 1) % len(ring)
+			}
+		} else {
+			for range size - passes {
+				head = (head - 1 + len(ring)) % len(ring)
+				ring[head] = ring[(head+size)%len(ring)]
 			}
 		}
 	}
+
+	pass := 0
+	for _, i := range members {
+		rotate(greaterBefore[i] - pass)
+		pass = greaterBefore[i]
+		ring[(head+size)%len(ring)] = i
+		size++
+	}
+	rotate(greater - pass)
+
+	for k := range members {
+		members[len(members)-1-k] = ring[(head+k)%len(ring)]
+	}
+}
+
+// SortStableBy sorts a slice using a comparison function, keeping equal elements
+// in their original order. Like SortBy, it accepts both strict and non-strict
+// comparison functions and returns a new slice.
+//
+// Example:
+//
+//	type User struct{ Name string; Age int }
+//	users := []User{{"Bob", 30}, {"Alice", 25}, {"Carol", 30}}
+//	sorted := SortStableBy(func(a, b User) bool { return a.Age < b.Age }, users)
+//	// Result: []User{{"Alice", 25}, {"Bob", 30}, {"Carol", 30}}
+func SortStableBy[T any](fn func(T, T) bool, slice []T) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	slices.SortStableFunc(result, compareFunc(fn))
 	return result
 }
 
+// SortByKey sorts a slice by a key extracted from each element.
+// The key function is called exactly once per element and the keys are cached
+// for the duration of the sort, which makes it cheaper than SortBy when key
+// extraction is expensive. The sort is stable and the input slice is not modified.
+//
+// Example:
+//
+//	words := []string{"banana", "kiwi", "apple"}
+//	sorted := SortByKey(func(s string) int { return len(s) }, words)
+//	// Result: []string{"kiwi", "apple", "banana"}
+func SortByKey[T any, K cmp.Ordered](keyFn func(T) K, slice []T) []T {
+	type keyed struct {
+		key   K
+		value T
+	}
+
+	pairs := make([]keyed, len(slice))
+	for i, v := range slice {
+		pairs[i] = keyed{key: keyFn(v), value: v}
+	}
+
+	slices.SortStableFunc(pairs, func(a, b keyed) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	result := make([]T, len(pairs))
+	for i, p := range pairs {
+		result[i] = p.value
+	}
+	return result
+}
+
+// compareFunc converts a "less" function into a three-way comparison suitable
+// for the slices package. Elements for which fn reports true in both directions
+// (as happens with <= on equal values) are treated as equal.
+func compareFunc[T any](fn func(T, T) bool) func(T, T) int {
+	return func(a, b T) int {
+		ab, ba := fn(a, b), fn(b, a)
+		switch {
+		case ab && !ba:
+			return -1
+		case ba && !ab:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
 // IndexBy creates a map from a slice using a function to generate keys.
 // Each element in the slice becomes a value in the map, with the key determined
 // by applying the provided function to the element. If multiple elements produce
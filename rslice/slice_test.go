@@ -1,6 +1,9 @@
 package rslice
 
 import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
 	"testing"
 )
 
@@ -290,6 +293,129 @@ func TestSortBy(t *testing.T) {
 	}
 }
 
+func TestSortByNonStrict(t *testing.T) {
+	numbers := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	sorted := SortBy(func(a, b int) bool { return a <= b }, numbers)
+	expected := []int{1, 1, 2, 3, 4, 5, 6, 9}
+
+	for i, v := range expected {
+		if sorted[i] != v {
+			t.Errorf("Expected %d at index %d, got %d", v, i, sorted[i])
+		}
+	}
+
+	// Original slice must not be modified
+	if numbers[0] != 3 {
+		t.Errorf("Expected original slice to be unchanged, got %v", numbers)
+	}
+}
+
+func TestSortByTieOrder(t *testing.T) {
+	type item struct {
+		K    int
+		Name string
+	}
+	strict := func(a, b item) bool { return a.K < b.K }
+	nonStrict := func(a, b item) bool { return a.K <= b.K }
+
+	tests := []struct {
+		less     func(a, b item) bool
+		input    []item
+		expected []item
+	}{
+		{
+			less:     strict,
+			input:    []item{{1, "a"}, {0, "b"}, {1, "c"}, {0, "d"}},
+			expected: []item{{0, "d"}, {0, "b"}, {1, "c"}, {1, "a"}},
+		},
+		{
+			// Ties are not simply reversed when larger elements sit between them
+			less:     strict,
+			input:    []item{{1, "a"}, {2, "b"}, {1, "c"}, {1, "d"}, {2, "e"}},
+			expected: []item{{1, "a"}, {1, "d"}, {1, "c"}, {2, "e"}, {2, "b"}},
+		},
+		{
+			less:     nonStrict,
+			input:    []item{{1, "a"}, {0, "b"}, {1, "c"}, {0, "d"}},
+			expected: []item{{0, "b"}, {0, "d"}, {1, "a"}, {1, "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		sorted := SortBy(tt.less, tt.input)
+		if !slices.Equal(sorted, tt.expected) {
+			t.Errorf("SortBy(%v) = %v, expected %v", tt.input, sorted, tt.expected)
+		}
+	}
+
+	// Results must match the previous bubble sort for any input
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 500 {
+		input := make([]item, rng.IntN(60))
+		for i := range input {
+			input[i] = item{K: rng.IntN(8), Name: fmt.Sprint(i)}
+		}
+		for _, less := range []func(a, b item) bool{strict, nonStrict} {
+			if sorted, expected := SortBy(less, input), bubbleSortBy(less, input); !slices.Equal(sorted, expected) {
+				t.Fatalf("SortBy(%v) = %v, expected %v", input, sorted, expected)
+			}
+		}
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "Bob", Age: 30},
+		{Name: "Alice", Age: 25},
+		{Name: "Carol", Age: 30},
+		{Name: "Dave", Age: 25},
+	}
+
+	expected := []string{"Alice", "Dave", "Bob", "Carol"}
+
+	for _, less := range []func(a, b User) bool{
+		func(a, b User) bool { return a.Age < b.Age },
+		func(a, b User) bool { return a.Age <= b.Age },
+	} {
+		sorted := SortStableBy(less, users)
+		for i, name := range expected {
+			if sorted[i].Name != name {
+				t.Errorf("Expected %s at index %d, got %s", name, i, sorted[i].Name)
+			}
+		}
+	}
+
+	// Test empty slice
+	if len(SortStableBy(func(a, b int) bool { return a < b }, []int{})) != 0 {
+		t.Error("Expected empty result for empty input")
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	words := []string{"banana", "kiwi", "apple", "fig", "plum"}
+	calls := 0
+	sorted := SortByKey(func(s string) int {
+		calls++
+		return len(s)
+	}, words)
+	expected := []string{"fig", "kiwi", "plum", "apple", "banana"}
+
+	if calls != len(words) {
+		t.Errorf("Expected key function to be called %d times, got %d", len(words), calls)
+	}
+
+	for i, v := range expected {
+		if sorted[i] != v {
+			t.Errorf("Expected %s at index %d, got %s", v, i, sorted[i])
+		}
+	}
+}
+
 func TestIndexBy(t *testing.T) {
 	type User struct {
 		ID   string
@@ -0,0 +1,188 @@
+package rslice
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// The functions in this file expect their input to be sorted with the same
+// comparison function that is passed to them, using the SortBy convention:
+// fn(a, b) reports whether a comes before b, and both strict (<) and
+// non-strict (<=) comparisons are accepted. Elements for which neither comes
+// before the other are considered equal.
+
+// BinarySearchBy searches a sorted slice for a target value. It returns the index
+// of the first element equal to the target and true, or the index where the
+// target would be inserted and false. It runs in O(log n) time.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 5, 7}
+//	index, found := BinarySearchBy(func(a, b int) bool { return a < b }, 5, numbers)
+//	// Result: index = 2, found = true
+func BinarySearchBy[T any](fn func(T, T) bool, target T, slice []T) (int, bool) {
+	return slices.BinarySearchFunc(slice, target, compareFunc(fn))
+}
+
+// LowerBound returns the index of the first element in a sorted slice that does
+// not come before the target, or len(slice) if there is none.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 3, 5}
+//	index := LowerBound(func(a, b int) bool { return a < b }, 3, numbers)
+//	// Result: 1
+func LowerBound[T any](fn func(T, T) bool, target T, slice []T) int {
+	index, _ := BinarySearchBy(fn, target, slice)
+	return index
+}
+
+// UpperBound returns the index of the first element in a sorted slice that comes
+// after the target, or len(slice) if there is none.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 3, 5}
+//	index := UpperBound(func(a, b int) bool { return a < b }, 3, numbers)
+//	// Result: 3
+func UpperBound[T any](fn func(T, T) bool, target T, slice []T) int {
+	compare := compareFunc(fn)
+	lo, hi := 0, len(slice)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if compare(target, slice[mid]) < 0 {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// InsertSorted returns a new sorted slice with the value inserted after any
+// elements equal to it, so repeated inserts keep insertion order.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 5}
+//	inserted := InsertSorted(func(a, b int) bool { return a < b }, 4, numbers)
+//	// Result: []int{1, 3, 4, 5}
+func InsertSorted[T any](fn func(T, T) bool, value T, slice []T) []T {
+	index := UpperBound(fn, value, slice)
+	result := make([]T, 0, len(slice)+1)
+	result = append(result, slice[:index]...)
+	result = append(result, value)
+	return append(result, slice[index:]...)
+}
+
+// RemoveSorted returns a new sorted slice with the first element equal to the
+// value removed. If no element is equal, the result is a copy of the input.
+//
+// Example:
+//
+//	numbers := []int{1, 3, 3, 5}
+//	removed := RemoveSorted(func(a, b int) bool { return a < b }, 3, numbers)
+//	// Result: []int{1, 3, 5}
+func RemoveSorted[T any](fn func(T, T) bool, value T, slice []T) []T {
+	index, found := BinarySearchBy(fn, value, slice)
+	if !found {
+		return append([]T{}, slice...)
+	}
+	result := make([]T, 0, len(slice)-1)
+	result = append(result, slice[:index]...)
+	return append(result, slice[index+1:]...)
+}
+
+// MergeSorted merges two sorted slices into a new sorted slice in O(len(a) + len(b))
+// time. The merge is stable: when elements are equal, those from a come first.
+//
+// Example:
+//
+//	merged := MergeSorted(func(a, b int) bool { return a < b }, []int{1, 4, 6}, []int{2, 3, 7})
+//	// Result: []int{1, 2, 3, 4, 6, 7}
+func MergeSorted[T any](fn func(T, T) bool, a, b []T) []T {
+	compare := compareFunc(fn)
+	result := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if compare(b[j], a[i]) < 0 {
+			result = append(result, b[j])
+			j++
+		} else {
+			result = append(result, a[i])
+			i++
+		}
+	}
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
+// MergeSortedK merges any number of sorted slices into a new sorted slice using a
+// heap, in O(n log k) time for n total elements across k slices. The merge is
+// stable: when elements are equal, those from earlier slices come first.
+//
+// Example:
+//
+//	less := func(a, b int) bool { return a < b }
+//	merged := MergeSortedK(less, []int{1, 5}, []int{2, 6}, []int{3, 4})
+//	// Result: []int{1, 2, 3, 4, 5, 6}
+func MergeSortedK[T any](fn func(T, T) bool, sorted ...[]T) []T {
+	total := 0
+	h := &mergeHeap[T]{compare: compareFunc(fn)}
+	for i, s := range sorted {
+		total += len(s)
+		if len(s) > 0 {
+			h.cursors = append(h.cursors, mergeCursor{source: i})
+		}
+	}
+	h.slices = sorted
+	heap.Init(h)
+
+	result := make([]T, 0, total)
+	for h.Len() > 0 {
+		top := &h.cursors[0]
+		source := h.slices[top.source]
+		result = append(result, source[top.index])
+		top.index++
+		if top.index < len(source) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return result
+}
+
+// mergeCursor tracks the next unread position of one input to MergeSortedK.
+type mergeCursor struct {
+	source int
+	index  int
+}
+
+// mergeHeap is a min-heap of cursors ordered by their current element, with ties
+// broken by source order to keep MergeSortedK stable.
+type mergeHeap[T any] struct {
+	slices  [][]T
+	cursors []mergeCursor
+	compare func(T, T) int
+}
+
+func (h *mergeHeap[T]) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	if c := h.compare(h.slices[a.source][a.index], h.slices[b.source][b.index]); c != 0 {
+		return c < 0
+	}
+	return a.source < b.source
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap[T]) Push(x any) { h.cursors = append(h.cursors, x.(mergeCursor)) }
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
@@ -0,0 +1,109 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestBinarySearchBy(t *testing.T) {
+	numbers := []int{1, 3, 3, 5, 7}
+
+	index, found := BinarySearchBy(intLess, 3, numbers)
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+
+	index, found = BinarySearchBy(intLess, 4, numbers)
+	assert.False(t, found)
+	assert.Equal(t, 3, index)
+
+	index, found = BinarySearchBy(intLess, 9, numbers)
+	assert.False(t, found)
+	assert.Equal(t, 5, index)
+
+	// Non-strict comparison behaves the same
+	index, found = BinarySearchBy(func(a, b int) bool { return a <= b }, 3, numbers)
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+
+	_, found = BinarySearchBy(intLess, 1, []int{})
+	assert.False(t, found)
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	numbers := []int{1, 3, 3, 5}
+
+	assert.Equal(t, 1, LowerBound(intLess, 3, numbers))
+	assert.Equal(t, 3, UpperBound(intLess, 3, numbers))
+	assert.Equal(t, 0, LowerBound(intLess, 0, numbers))
+	assert.Equal(t, 0, UpperBound(intLess, 0, numbers))
+	assert.Equal(t, 4, LowerBound(intLess, 6, numbers))
+	assert.Equal(t, 4, UpperBound(intLess, 6, numbers))
+	assert.Equal(t, 3, UpperBound(func(a, b int) bool { return a <= b }, 3, numbers))
+}
+
+func TestInsertSorted(t *testing.T) {
+	numbers := []int{1, 3, 5}
+	assert.Equal(t, []int{1, 3, 4, 5}, InsertSorted(intLess, 4, numbers))
+	assert.Equal(t, []int{0, 1, 3, 5}, InsertSorted(intLess, 0, numbers))
+	assert.Equal(t, []int{1, 3, 5, 6}, InsertSorted(intLess, 6, numbers))
+	assert.Equal(t, []int{1, 3, 5}, numbers)
+
+	// Equal elements keep insertion order
+	type entry struct {
+		Key   int
+		Label string
+	}
+	byKey := func(a, b entry) bool { return a.Key < b.Key }
+	entries := InsertSorted(byKey, entry{1, "second"}, []entry{{1, "first"}, {2, "third"}})
+	assert.Equal(t, []entry{{1, "first"}, {1, "second"}, {2, "third"}}, entries)
+}
+
+func TestRemoveSorted(t *testing.T) {
+	numbers := []int{1, 3, 3, 5}
+	assert.Equal(t, []int{1, 3, 5}, RemoveSorted(intLess, 3, numbers))
+	assert.Equal(t, []int{1, 3, 3, 5}, RemoveSorted(intLess, 4, numbers))
+	assert.Equal(t, []int{3, 3, 5}, RemoveSorted(intLess, 1, numbers))
+	assert.Equal(t, []int{1, 3, 3, 5}, numbers)
+}
+
+func TestMergeSorted(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 7}, MergeSorted(intLess, []int{1, 4, 6}, []int{2, 3, 7}))
+	assert.Equal(t, []int{1, 2}, MergeSorted(intLess, []int{}, []int{1, 2}))
+	assert.Equal(t, []int{}, MergeSorted(intLess, []int{}, []int{}))
+
+	// Stable: ties keep elements from a first
+	type entry struct {
+		Key    int
+		Source string
+	}
+	byKey := func(a, b entry) bool { return a.Key < b.Key }
+	merged := MergeSorted(byKey, []entry{{1, "a"}, {2, "a"}}, []entry{{1, "b"}, {2, "b"}})
+	assert.Equal(t, []entry{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, merged)
+}
+
+func TestMergeSortedK(t *testing.T) {
+	merged := MergeSortedK(intLess, []int{1, 5, 9}, []int{2, 6}, []int{}, []int{3, 4, 10})
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 9, 10}, merged)
+
+	assert.Equal(t, []int{}, MergeSortedK[int](intLess))
+	assert.Equal(t, []int{1, 2}, MergeSortedK(intLess, []int{1, 2}))
+
+	type entry struct {
+		Key    int
+		Source int
+	}
+	byKey := func(a, b entry) bool { return a.Key < b.Key }
+	stable := MergeSortedK(byKey,
+		[]entry{{1, 0}, {2, 0}},
+		[]entry{{1, 1}},
+		[]entry{{1, 2}, {2, 2}},
+	)
+	assert.Equal(t, []entry{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 2}}, stable)
+
+	// Matches sorting the concatenation
+	shards := [][]int{{1, 4, 4, 8}, {0, 2, 9}, {3, 4, 5}}
+	assert.Equal(t, SortBy(intLess, Flatten(shards)), MergeSortedK(intLess, shards...))
+}
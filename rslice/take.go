@@ -0,0 +1,101 @@
+package rslice
+
+// Like Take and Drop, every function in this file returns a new slice that does
+// not share memory with the input.
+
+// TakeWhile returns the leading elements of a slice that satisfy the predicate
+// function, stopping at the first element that doesn't.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 1, 4}
+//	small := TakeWhile(func(n int) bool { return n < 3 }, numbers)
+//	// Result: []int{1, 2}
+func TakeWhile[T any](fn func(T) bool, slice []T) []T {
+	i := 0
+	for i < len(slice) && fn(slice[i]) {
+		i++
+	}
+	return append([]T{}, slice[:i]...)
+}
+
+// DropWhile returns a slice with the leading elements that satisfy the predicate
+// function removed.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 1, 4}
+//	rest := DropWhile(func(n int) bool { return n < 3 }, numbers)
+//	// Result: []int{3, 1, 4}
+func DropWhile[T any](fn func(T) bool, slice []T) []T {
+	i := 0
+	for i < len(slice) && fn(slice[i]) {
+		i++
+	}
+	return append([]T{}, slice[i:]...)
+}
+
+// TakeLast returns the last n elements from a slice.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	lastTwo := TakeLast(2, numbers)
+//	// Result: []int{4, 5}
+func TakeLast[T any](n int, slice []T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	if n >= len(slice) {
+		return append([]T{}, slice...)
+	}
+	return append([]T{}, slice[len(slice)-n:]...)
+}
+
+// DropLast returns a slice with the last n elements removed.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	withoutLastTwo := DropLast(2, numbers)
+//	// Result: []int{1, 2, 3}
+func DropLast[T any](n int, slice []T) []T {
+	if n <= 0 {
+		return append([]T{}, slice...)
+	}
+	if n >= len(slice) {
+		return []T{}
+	}
+	return append([]T{}, slice[:len(slice)-n]...)
+}
+
+// TakeLastWhile returns the trailing elements of a slice that satisfy the predicate
+// function, scanning backwards and stopping at the first element that doesn't.
+//
+// Example:
+//
+//	numbers := []int{1, 4, 2, 3}
+//	small := TakeLastWhile(func(n int) bool { return n < 4 }, numbers)
+//	// Result: []int{2, 3}
+func TakeLastWhile[T any](fn func(T) bool, slice []T) []T {
+	i := len(slice)
+	for i > 0 && fn(slice[i-1]) {
+		i--
+	}
+	return append([]T{}, slice[i:]...)
+}
+
+// FindLast returns the last element that satisfies the predicate function, along with a boolean indicating if an element was found.
+//
+// Example:
+//
+//	numbers := []int{1, 2, 3, 4, 5}
+//	found, exists := FindLast(func(n int) bool { return n%2 == 0 }, numbers)
+//	// Result: found = 4, exists = true
+func FindLast[T any](fn func(T) bool, slice []T) (T, bool) {
+	if i := FindLastIndex(fn, slice); i >= 0 {
+		return slice[i], true
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,66 @@
+package rslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTakeWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+
+	assert.Equal(t, []int{1, 2}, TakeWhile(lessThan3, []int{1, 2, 3, 1, 4}))
+	assert.Equal(t, []int{}, TakeWhile(lessThan3, []int{5, 1}))
+	assert.Equal(t, []int{1, 2}, TakeWhile(lessThan3, []int{1, 2}))
+	assert.Equal(t, []int{}, TakeWhile(lessThan3, []int{}))
+}
+
+func TestDropWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+
+	assert.Equal(t, []int{3, 1, 4}, DropWhile(lessThan3, []int{1, 2, 3, 1, 4}))
+	assert.Equal(t, []int{5, 1}, DropWhile(lessThan3, []int{5, 1}))
+	assert.Equal(t, []int{}, DropWhile(lessThan3, []int{1, 2}))
+}
+
+func TestTakeLast(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, []int{4, 5}, TakeLast(2, numbers))
+	assert.Equal(t, numbers, TakeLast(10, numbers))
+	assert.Equal(t, []int{}, TakeLast(0, numbers))
+	assert.Equal(t, []int{}, TakeLast(-1, numbers))
+
+	// Result must not alias the input
+	last := TakeLast(2, numbers)
+	last[0] = 99
+	assert.Equal(t, 4, numbers[3])
+}
+
+func TestDropLast(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, []int{1, 2, 3}, DropLast(2, numbers))
+	assert.Equal(t, []int{}, DropLast(10, numbers))
+	assert.Equal(t, numbers, DropLast(0, numbers))
+	assert.Equal(t, numbers, DropLast(-1, numbers))
+}
+
+func TestTakeLastWhile(t *testing.T) {
+	lessThan4 := func(n int) bool { return n < 4 }
+
+	assert.Equal(t, []int{2, 3}, TakeLastWhile(lessThan4, []int{1, 4, 2, 3}))
+	assert.Equal(t, []int{}, TakeLastWhile(lessThan4, []int{1, 4}))
+	assert.Equal(t, []int{1, 2}, TakeLastWhile(lessThan4, []int{1, 2}))
+}
+
+func TestFindLast(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	found, exists := FindLast(func(n int) bool { return n%2 == 0 }, numbers)
+	assert.True(t, exists)
+	assert.Equal(t, 4, found)
+
+	_, exists = FindLast(func(n int) bool { return n > 10 }, numbers)
+	assert.False(t, exists)
+}
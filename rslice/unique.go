@@ -0,0 +1,103 @@
+package rslice
+
+// UniqueBy returns a new slice with elements removed when their key has already
+// been seen. The key function makes it usable with non-comparable elements such
+// as structs containing slices or maps. The first occurrence of each key is kept.
+// It runs in O(n) time.
+//
+// Example:
+//
+//	type User struct{ ID int; Roles []string }
+//	users := []User{{ID: 1}, {ID: 2}, {ID: 1, Roles: []string{"admin"}}}
+//	unique := UniqueBy(func(u User) int { return u.ID }, users)
+//	// Result: []User{{ID: 1}, {ID: 2}}
+func UniqueBy[T any, K comparable](fn func(T) K, slice []T) []T {
+	seen := make(map[K]struct{})
+	result := make([]T, 0, len(slice))
+
+	for _, v := range slice {
+		key := fn(v)
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// UniqueWith returns a new slice with duplicate elements removed, using a custom
+// equality function. The first occurrence of each element is kept.
+// Because no key can be hashed, it runs in O(n²) time; prefer UniqueBy when a
+// comparable key can be derived from each element.
+//
+// Example:
+//
+//	words := []string{"Go", "go", "Rust", "GO"}
+//	unique := UniqueWith(strings.EqualFold, words)
+//	// Result: []string{"Go", "Rust"}
+func UniqueWith[T any](fn func(T, T) bool, slice []T) []T {
+	result := make([]T, 0, len(slice))
+
+	for _, v := range slice {
+		if !Any(func(kept T) bool { return fn(kept, v) }, result) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// DedupeAdjacent returns a new slice with consecutive duplicate elements collapsed
+// into one. Equal elements that are not adjacent are kept, which makes it the
+// cheap way to deduplicate a sorted slice. It runs in O(n) time.
+//
+// Example:
+//
+//	numbers := []int{1, 1, 2, 2, 2, 1, 3, 3}
+//	deduped := DedupeAdjacent(numbers)
+//	// Result: []int{1, 2, 1, 3}
+func DedupeAdjacent[T comparable](slice []T) []T {
+	result := make([]T, 0, len(slice))
+
+	for i, v := range slice {
+		if i == 0 || v != slice[i-1] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Duplicates returns the elements that appear more than once, along with the
+// number of times each appears. Elements are listed in the order of their first
+// occurrence. It runs in O(n) time.
+//
+// Example:
+//
+//	words := []string{"a", "b", "a", "c", "b", "a"}
+//	dups := Duplicates(words)
+//	// Result: []struct{Value string; Count int}{{"a", 3}, {"b", 2}}
+func Duplicates[T comparable](slice []T) []struct {
+	Value T
+	Count int
+} {
+	counts := make(map[T]int)
+	for _, v := range slice {
+		counts[v]++
+	}
+
+	result := []struct {
+		Value T
+		Count int
+	}{}
+	for _, v := range slice {
+		count := counts[v]
+		if count > 1 {
+			result = append(result, struct {
+				Value T
+				Count int
+			}{v, count})
+			// Zero the count so later occurrences are not reported again
+			counts[v] = 0
+		}
+	}
+	return result
+}
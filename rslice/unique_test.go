@@ -0,0 +1,50 @@
+package rslice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueBy(t *testing.T) {
+	type User struct {
+		ID    int
+		Roles []string
+	}
+
+	users := []User{{ID: 1}, {ID: 2}, {ID: 1, Roles: []string{"admin"}}, {ID: 3}}
+	unique := UniqueBy(func(u User) int { return u.ID }, users)
+
+	assert.Equal(t, []User{{ID: 1}, {ID: 2}, {ID: 3}}, unique)
+	assert.Empty(t, UniqueBy(func(u User) int { return u.ID }, []User{}))
+}
+
+func TestUniqueWith(t *testing.T) {
+	words := []string{"Go", "go", "Rust", "GO", "rust", "Zig"}
+	unique := UniqueWith(strings.EqualFold, words)
+	assert.Equal(t, []string{"Go", "Rust", "Zig"}, unique)
+
+	// Non-comparable elements
+	sets := [][]int{{1, 2}, {2, 1}, {3}}
+	sameLen := UniqueWith(func(a, b []int) bool { return len(a) == len(b) }, sets)
+	assert.Equal(t, [][]int{{1, 2}, {3}}, sameLen)
+}
+
+func TestDedupeAdjacent(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 1, 3}, DedupeAdjacent([]int{1, 1, 2, 2, 2, 1, 3, 3}))
+	assert.Equal(t, []int{1}, DedupeAdjacent([]int{1, 1, 1}))
+	assert.Equal(t, []int{}, DedupeAdjacent([]int{}))
+}
+
+func TestDuplicates(t *testing.T) {
+	dups := Duplicates([]string{"a", "b", "a", "c", "b", "a"})
+
+	assert.Len(t, dups, 2)
+	assert.Equal(t, "a", dups[0].Value)
+	assert.Equal(t, 3, dups[0].Count)
+	assert.Equal(t, "b", dups[1].Value)
+	assert.Equal(t, 2, dups[1].Count)
+
+	assert.Empty(t, Duplicates([]int{1, 2, 3}))
+}
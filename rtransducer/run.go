@@ -0,0 +1,144 @@
+package rtransducer
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+// The functions in this file run a transducer over a source. Every source is
+// consumed as an iter.Seq: use slices.Values for a slice, FromChan for a
+// channel and Lines for an io.Reader.
+
+// Transduce runs a transducer over a sequence and folds the results with a
+// reducing function, in the style of rslice.Reduce.
+//
+// Example:
+//
+//	xf := Pipe(Map(func(s string) int { return len(s) }), Filter(func(n int) bool { return n > 2 }))
+//	total := Transduce(xf, func(acc, n int) int { return acc + n }, 0, slices.Values(words))
+func Transduce[T, R, A any](xf Transducer[T, R], fn func(A, R) A, initial A, seq iter.Seq[T]) A {
+	result := initial
+	run(xf, seq, func(r R) bool {
+		result = fn(result, r)
+		return true
+	})
+	return result
+}
+
+// IntoSlice runs a transducer over a sequence and collects the results in a slice.
+//
+// Example:
+//
+//	xf := Pipe(Map(func(n int) int { return n * 2 }), Take[int](2))
+//	result := IntoSlice(xf, slices.Values([]int{1, 2, 3}))
+//	// Result: []int{2, 4}
+func IntoSlice[T, R any](xf Transducer[T, R], seq iter.Seq[T]) []R {
+	return Transduce(xf, func(acc []R, r R) []R { return append(acc, r) }, []R{}, seq)
+}
+
+// IntoMap runs a transducer over a sequence and collects the results in a map,
+// keyed by a key function in the style of rslice.IndexBy. If several results
+// share a key, the last one wins.
+//
+// Example:
+//
+//	byID := IntoMap(Filter(isActive), func(u User) string { return u.ID }, slices.Values(users))
+func IntoMap[T, R any, K comparable](xf Transducer[T, R], keyFn func(R) K, seq iter.Seq[T]) map[K]R {
+	result := make(map[K]R)
+	run(xf, seq, func(r R) bool {
+		result[keyFn(r)] = r
+		return true
+	})
+	return result
+}
+
+// IntoChan runs a transducer over a sequence and sends every result on a
+// channel, blocking until each send completes. It returns when the source is
+// exhausted or the transducer terminates the run. The channel is not closed, so
+// the caller decides when the stream ends.
+//
+// Example:
+//
+//	out := make(chan string)
+//	go func() {
+//		defer close(out)
+//		IntoChan(Map(strings.ToUpper), out, FromChan(in))
+//	}()
+func IntoChan[T, R any](xf Transducer[T, R], ch chan<- R, seq iter.Seq[T]) {
+	run(xf, seq, func(r R) bool {
+		ch <- r
+		return true
+	})
+}
+
+// Apply returns a lazy sequence of the results of running a transducer over a
+// sequence. The transducer runs afresh each time the result is ranged over,
+// and stopping the range early terminates the run.
+//
+// Example:
+//
+//	for s := range Apply(Map(strconv.Itoa), slices.Values([]int{1, 2})) {
+//		fmt.Println(s)
+//	}
+func Apply[T, R any](xf Transducer[T, R], seq iter.Seq[T]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		run(xf, seq, yield)
+	}
+}
+
+// FromChan returns a sequence of the values received from a channel until it
+// is closed. If the range stops early, the remaining values stay in the channel.
+//
+// Example:
+//
+//	result := IntoSlice(Take[int](10), FromChan(ch))
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Lines returns a sequence of the lines read from r, without line terminators,
+// along with a function that reports the first non-EOF read error once the
+// sequence has been consumed, like bufio.Scanner.Err.
+//
+// Example:
+//
+//	lines, errFn := Lines(file)
+//	rows := IntoSlice(Pipe(Drop[string](1), Map(parseRow)), lines)
+//	if err := errFn(); err != nil {
+//		return err
+//	}
+func Lines(r io.Reader) (iter.Seq[string], func() error) {
+	var err error
+	seq := func(yield func(string) bool) {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+		err = scanner.Err()
+	}
+	return seq, func() error { return err }
+}
+
+// run feeds every value of seq through xf into sink, stopping as soon as any
+// stage asks to, and then signals completion. A pipeline that is complete from
+// the start never reads seq.
+func run[T, R any](xf Transducer[T, R], seq iter.Seq[T], sink func(R) bool) {
+	reducer := xf(Reducer[R]{Step: sink, Done: func() {}})
+	if !reducer.Complete {
+		for v := range seq {
+			if !reducer.Step(v) {
+				break
+			}
+		}
+	}
+	reducer.Done()
+}
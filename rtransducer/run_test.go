@@ -0,0 +1,95 @@
+package rtransducer
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// evenStrings is a pipeline reused across every source in these tests.
+var evenStrings = Pipe3(
+	Filter(func(n int) bool { return n%2 == 0 }),
+	Map(strconv.Itoa),
+	Take[string](2),
+)
+
+func TestTransduce(t *testing.T) {
+	total := Transduce(
+		Map(func(s string) int { return len(s) }),
+		func(acc, n int) int { return acc + n },
+		0,
+		slices.Values([]string{"go", "is", "fun"}),
+	)
+	assert.Equal(t, 7, total)
+}
+
+func TestIntoSlice(t *testing.T) {
+	assert.Equal(t, []string{"2", "4"}, IntoSlice(evenStrings, slices.Values([]int{1, 2, 3, 4, 5, 6})))
+}
+
+func TestIntoMap(t *testing.T) {
+	byLength := IntoMap(Filter(func(s string) bool { return s != "" }), func(s string) int { return len(s) },
+		slices.Values([]string{"a", "", "bb", "cc"}))
+	assert.Equal(t, map[int]string{1: "a", 2: "cc"}, byLength)
+}
+
+func TestIntoChan(t *testing.T) {
+	out := make(chan string, 10)
+	IntoChan(evenStrings, out, slices.Values([]int{1, 2, 3, 4, 5, 6}))
+	close(out)
+
+	var received []string
+	for s := range out {
+		received = append(received, s)
+	}
+	assert.Equal(t, []string{"2", "4"}, received)
+}
+
+func TestApply(t *testing.T) {
+	seq := Apply(evenStrings, slices.Values([]int{1, 2, 3, 4, 5, 6}))
+	assert.Equal(t, []string{"2", "4"}, slices.Collect(seq))
+	// Running again starts from fresh state
+	assert.Equal(t, []string{"2", "4"}, slices.Collect(seq))
+
+	for s := range seq {
+		assert.Equal(t, "2", s)
+		break
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	in := make(chan int, 6)
+	for i := 1; i <= 6; i++ {
+		in <- i
+	}
+	close(in)
+
+	assert.Equal(t, []string{"2", "4"}, IntoSlice(evenStrings, FromChan(in)))
+	// Values after the run terminated remain in the channel
+	assert.Equal(t, 5, <-in)
+
+	// A pipeline that takes nothing leaves the channel untouched
+	assert.Equal(t, []int{}, IntoSlice(Pipe(Map(func(n int) int { return n }), Take[int](0)), FromChan(in)))
+	assert.Equal(t, 6, <-in)
+}
+
+func TestLines(t *testing.T) {
+	lines, errFn := Lines(strings.NewReader("1\n2\n3\n4\n"))
+	parse := Map(func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	})
+
+	assert.Equal(t, []string{"2", "4"}, IntoSlice(Pipe(parse, evenStrings), lines))
+	assert.NoError(t, errFn())
+
+	errRead := errors.New("read failed")
+	lines, errFn = Lines(iotest.ErrReader(errRead))
+	assert.Empty(t, IntoSlice(Identity[string](), lines))
+	assert.ErrorIs(t, errFn(), errRead)
+}
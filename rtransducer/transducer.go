@@ -0,0 +1,238 @@
+package rtransducer
+
+// Reducer is the receiving end of a transformation. Step consumes one value and
+// reports whether more values are wanted; returning false terminates the run
+// early. Done is called exactly once when the run ends, either because the
+// source is exhausted or because a Step returned false, so stateful stages can
+// flush what they hold. Complete reports that the reducer wants no values at all,
+// such as after Take(0); the run then ends without reading the source. Stages
+// that wrap another reducer pass its Complete on.
+type Reducer[T any] struct {
+	Step     func(T) bool
+	Done     func()
+	Complete bool
+}
+
+// Transducer turns a Reducer of R into a Reducer of T. It describes a
+// transformation from T to R without knowing where the values come from or
+// where they go, so the same pipeline can run over a slice, a channel, an
+// iter.Seq or an io.Reader. A Transducer is called once per run, so stateful
+// stages such as Take start fresh every time.
+type Transducer[T, R any] func(next Reducer[R]) Reducer[T]
+
+// Pipe composes two transducers so that values flow through first, then second.
+//
+// Example:
+//
+//	xf := Pipe(
+//		Filter(func(n int) bool { return n%2 == 0 }),
+//		Map(strconv.Itoa),
+//	)
+//	result := IntoSlice(xf, slices.Values([]int{1, 2, 3, 4}))
+//	// Result: []string{"2", "4"}
+func Pipe[A, B, C any](first Transducer[A, B], second Transducer[B, C]) Transducer[A, C] {
+	return func(next Reducer[C]) Reducer[A] {
+		return first(second(next))
+	}
+}
+
+// Pipe3 composes three transducers so that values flow through them from left to right.
+func Pipe3[A, B, C, D any](first Transducer[A, B], second Transducer[B, C], third Transducer[C, D]) Transducer[A, D] {
+	return Pipe(Pipe(first, second), third)
+}
+
+// Pipe4 composes four transducers so that values flow through them from left to right.
+func Pipe4[A, B, C, D, E any](first Transducer[A, B], second Transducer[B, C], third Transducer[C, D], fourth Transducer[D, E]) Transducer[A, E] {
+	return Pipe(Pipe3(first, second, third), fourth)
+}
+
+// Identity returns a transducer that passes every value through unchanged.
+func Identity[T any]() Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		return next
+	}
+}
+
+// Map returns a transducer that applies a function to each value.
+// It accepts the same callbacks as rslice.Map.
+//
+// Example:
+//
+//	double := Map(func(n int) int { return n * 2 })
+func Map[T, R any](fn func(T) R) Transducer[T, R] {
+	return func(next Reducer[R]) Reducer[T] {
+		return Reducer[T]{
+			Step:     func(v T) bool { return next.Step(fn(v)) },
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// Filter returns a transducer that keeps only the values that satisfy the
+// predicate function. It accepts the same callbacks as rslice.Filter.
+//
+// Example:
+//
+//	even := Filter(func(n int) bool { return n%2 == 0 })
+func Filter[T any](fn func(T) bool) Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		return Reducer[T]{
+			Step: func(v T) bool {
+				if fn(v) {
+					return next.Step(v)
+				}
+				return true
+			},
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// FlatMap returns a transducer that applies a function returning a slice to each
+// value and passes on every element of the result.
+// It accepts the same callbacks as rslice.FlatMap.
+//
+// Example:
+//
+//	words := FlatMap(strings.Fields)
+func FlatMap[T, R any](fn func(T) []R) Transducer[T, R] {
+	return func(next Reducer[R]) Reducer[T] {
+		return Reducer[T]{
+			Step: func(v T) bool {
+				for _, r := range fn(v) {
+					if !next.Step(r) {
+						return false
+					}
+				}
+				return true
+			},
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// Take returns a transducer that passes on the first n values and then
+// terminates the run, so no further input is read. With n less than or equal to
+// zero the run ends before reading anything.
+//
+// Example:
+//
+//	firstThree := Take[int](3)
+func Take[T any](n int) Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		taken := 0
+		return Reducer[T]{
+			Step: func(v T) bool {
+				if taken >= n {
+					return false
+				}
+				taken++
+				return next.Step(v) && taken < n
+			},
+			Done:     next.Done,
+			Complete: n <= 0 || next.Complete,
+		}
+	}
+}
+
+// TakeWhile returns a transducer that passes on values while they satisfy the
+// predicate function and terminates the run at the first one that doesn't.
+//
+// Example:
+//
+//	small := TakeWhile(func(n int) bool { return n < 10 })
+func TakeWhile[T any](fn func(T) bool) Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		return Reducer[T]{
+			Step: func(v T) bool {
+				return fn(v) && next.Step(v)
+			},
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// Drop returns a transducer that discards the first n values.
+//
+// Example:
+//
+//	skipHeader := Drop[string](1)
+func Drop[T any](n int) Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		dropped := 0
+		return Reducer[T]{
+			Step: func(v T) bool {
+				if dropped < n {
+					dropped++
+					return true
+				}
+				return next.Step(v)
+			},
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// DropWhile returns a transducer that discards values while they satisfy the
+// predicate function and passes on everything from the first one that doesn't.
+//
+// Example:
+//
+//	skipBlank := DropWhile(func(s string) bool { return s == "" })
+func DropWhile[T any](fn func(T) bool) Transducer[T, T] {
+	return func(next Reducer[T]) Reducer[T] {
+		dropping := true
+		return Reducer[T]{
+			Step: func(v T) bool {
+				if dropping && fn(v) {
+					return true
+				}
+				dropping = false
+				return next.Step(v)
+			},
+			Done:     next.Done,
+			Complete: next.Complete,
+		}
+	}
+}
+
+// Chunk returns a transducer that groups values into slices of the given size.
+// The final, possibly shorter, chunk is passed on when the run ends. Each chunk
+// is a newly allocated slice. A size less than or equal to zero is treated as 1.
+//
+// Example:
+//
+//	batches := Chunk[Row](500)
+func Chunk[T any](size int) Transducer[T, []T] {
+	size = max(1, size)
+	return func(next Reducer[[]T]) Reducer[T] {
+		chunk := make([]T, 0, size)
+		stopped := next.Complete
+		return Reducer[T]{
+			Step: func(v T) bool {
+				chunk = append(chunk, v)
+				if len(chunk) < size {
+					return true
+				}
+				full := chunk
+				chunk = make([]T, 0, size)
+				if !next.Step(full) {
+					stopped = true
+				}
+				return !stopped
+			},
+			Done: func() {
+				if !stopped && len(chunk) > 0 {
+					next.Step(chunk)
+				}
+				next.Done()
+			},
+			Complete: next.Complete,
+		}
+	}
+}
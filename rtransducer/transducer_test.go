@@ -0,0 +1,119 @@
+package rtransducer
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jkaveri/ramda/rslice"
+	"github.com/stretchr/testify/assert"
+)
+
+// counting yields 1..n and records how many values were read.
+func counting(n int, read *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 1; i <= n; i++ {
+			*read++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, IntoSlice(Map(strconv.Itoa), slices.Values([]int{1, 2})))
+}
+
+func TestFilter(t *testing.T) {
+	even := Filter(func(n int) bool { return n%2 == 0 })
+	assert.Equal(t, []int{2, 4}, IntoSlice(even, slices.Values([]int{1, 2, 3, 4})))
+}
+
+func TestFlatMap(t *testing.T) {
+	words := IntoSlice(FlatMap(strings.Fields), slices.Values([]string{"a b", "", "c"}))
+	assert.Equal(t, []string{"a", "b", "c"}, words)
+
+	read := 0
+	result := IntoSlice(Pipe(FlatMap(func(n int) []int { return []int{n, n} }), Take[int](3)), counting(10, &read))
+	assert.Equal(t, []int{1, 1, 2}, result)
+	assert.Equal(t, 2, read)
+}
+
+func TestTake(t *testing.T) {
+	read := 0
+	assert.Equal(t, []int{1, 2, 3}, IntoSlice(Take[int](3), counting(10, &read)))
+	assert.Equal(t, 3, read, "Take must not read past the n-th value")
+
+	assert.Equal(t, []int{1, 2}, IntoSlice(Take[int](5), slices.Values([]int{1, 2})))
+	assert.Equal(t, []int{}, IntoSlice(Take[int](0), slices.Values([]int{1, 2})))
+
+	// Taking nothing reads nothing, wherever Take sits in the pipeline
+	read = 0
+	assert.Equal(t, []int{}, IntoSlice(Take[int](0), counting(10, &read)))
+	assert.Equal(t, []int{}, IntoSlice(Take[int](-1), counting(10, &read)))
+	assert.Equal(t, [][]int{}, IntoSlice(Pipe3(Filter(func(int) bool { return true }), Chunk[int](2), Take[[]int](0)), counting(10, &read)))
+	assert.Equal(t, 0, read)
+
+	// State is fresh for every run
+	xf := Take[int](1)
+	assert.Equal(t, []int{1}, IntoSlice(xf, slices.Values([]int{1, 2})))
+	assert.Equal(t, []int{1}, IntoSlice(xf, slices.Values([]int{1, 2})))
+}
+
+func TestTakeWhile(t *testing.T) {
+	read := 0
+	small := TakeWhile(func(n int) bool { return n < 3 })
+	assert.Equal(t, []int{1, 2}, IntoSlice(small, counting(10, &read)))
+	assert.Equal(t, 3, read)
+}
+
+func TestDrop(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, IntoSlice(Drop[int](2), slices.Values([]int{1, 2, 3, 4})))
+	assert.Equal(t, []int{}, IntoSlice(Drop[int](5), slices.Values([]int{1, 2})))
+}
+
+func TestDropWhile(t *testing.T) {
+	xf := DropWhile(func(n int) bool { return n < 3 })
+	assert.Equal(t, []int{3, 1, 4}, IntoSlice(xf, slices.Values([]int{1, 2, 3, 1, 4})))
+}
+
+func TestChunk(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, IntoSlice(Chunk[int](2), slices.Values([]int{1, 2, 3, 4, 5})))
+	assert.Equal(t, [][]int{{1}, {2}}, IntoSlice(Chunk[int](0), slices.Values([]int{1, 2})))
+	assert.Equal(t, [][]int{}, IntoSlice(Chunk[int](2), slices.Values([]int{})))
+
+	// A partial chunk is not flushed after downstream has stopped
+	read := 0
+	firstTwo := IntoSlice(Pipe(Chunk[int](2), Take[[]int](2)), counting(9, &read))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, firstTwo)
+	assert.Equal(t, 4, read)
+
+	// Flushed chunks still flow through downstream stages
+	sums := IntoSlice(Pipe(Chunk[int](2), Map(rslice.Sum[int])), slices.Values([]int{1, 2, 3}))
+	assert.Equal(t, []int{3, 3}, sums)
+}
+
+func TestPipe(t *testing.T) {
+	xf := Pipe3(
+		Map(func(n int) int { return n * 10 }),
+		Filter(func(n int) bool { return n != 20 }),
+		Map(strconv.Itoa),
+	)
+	assert.Equal(t, []string{"10", "30"}, IntoSlice(xf, slices.Values([]int{1, 2, 3})))
+
+	xf4 := Pipe4(Identity[int](), Drop[int](1), Take[int](2), Map(strconv.Itoa))
+	assert.Equal(t, []string{"2", "3"}, IntoSlice(xf4, slices.Values([]int{1, 2, 3, 4})))
+}
+
+func TestRslicePredicatesInPipeline(t *testing.T) {
+	// Existing rslice callbacks compose with transducers
+	xf := Pipe(
+		Map(func(words []string) []string { return rslice.Unique(words) }),
+		Filter(func(words []string) bool { return rslice.Any(func(w string) bool { return w == "go" }, words) }),
+	)
+	input := [][]string{{"go", "go", "fp"}, {"rust"}}
+	assert.Equal(t, [][]string{{"go", "fp"}}, IntoSlice(xf, slices.Values(input)))
+}